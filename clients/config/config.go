@@ -51,3 +51,12 @@ func WithSecretKey(secretKey string) Option {
 		c.secretKey = secretKey
 	}
 }
+
+// SetHeaders adds the given headers to the request, it must run after the method is chosen since
+// gorequest clears the headers on Get and Post.
+func SetHeaders(agent *gorequest.SuperAgent, headers map[string]string) *gorequest.SuperAgent {
+	for key, value := range headers {
+		agent = agent.Set(key, value)
+	}
+	return agent
+}
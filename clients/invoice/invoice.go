@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"net/http"
 	clientConfig "order-service/clients/config"
+	"order-service/common/metrics"
 	"order-service/common/sentry"
 	"order-service/config"
 	"order-service/constant"
+	"time"
 )
 
 type IInvoice struct {
@@ -35,7 +37,7 @@ func (p *IInvoice) GenerateInvoice(ctx context.Context, request *InvoiceRequest)
 	var (
 		span = p.sentry.StartSpan(ctx, logCtx)
 	)
-	p.sentry.SpanContext(span)
+	ctx = p.sentry.SpanContext(span)
 	defer p.sentry.Finish(span)
 
 	body, err := json.Marshal(request)
@@ -43,12 +45,19 @@ func (p *IInvoice) GenerateInvoice(ctx context.Context, request *InvoiceRequest)
 		return nil, err
 	}
 
-	clone := p.client.Client().Clone()
-	resp, bodyResp, errs := clone.
+	clone := p.client.Client().Clone().
 		Post(fmt.Sprintf("%s/api/v1/invoice/generate", p.client.BaseURL())).
-		Set(constant.XApiKey, config.Config.InternalService.Invoice.StaticKey).
+		Set(constant.XApiKey, config.Config.InternalService.Invoice.StaticKey)
+	if request.IdempotencyKey != "" {
+		clone = clone.Set(constant.XIdempotencyKey, request.IdempotencyKey)
+	}
+
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+	start := time.Now()
+	resp, bodyResp, errs := clone.
 		Send(string(body)).
 		End()
+	metrics.ObserveClient("invoice", "generate_invoice", start, resp, errs)
 
 	var errResponse ErrorInvoiceResponse
 	if resp.StatusCode != http.StatusOK || len(errs) > 0 {
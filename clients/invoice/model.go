@@ -5,10 +5,11 @@ import (
 )
 
 type InvoiceRequest struct {
-	InvoiceNumber string `json:"invoice_number"`
-	TemplateID    string `json:"template_id"`
-	Data          Data   `json:"data"`
-	CreatedBy     string `json:"created_by"`
+	InvoiceNumber  string `json:"invoice_number"`
+	TemplateID     string `json:"template_id"`
+	Data           Data   `json:"data"`
+	CreatedBy      string `json:"created_by"`
+	IdempotencyKey string `json:"-"`
 }
 
 type Data struct {
@@ -16,6 +17,8 @@ type Data struct {
 	PaymentDetail PaymentDetail `json:"payment_detail"`
 	Items         []Item        `json:"items"`
 	Total         string        `json:"total"`
+	Currency      string        `json:"currency"`
+	Locale        string        `json:"locale"`
 }
 
 type Customer struct {
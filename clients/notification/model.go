@@ -1,18 +1,20 @@
 package clients
 
 type NotificationRequest struct {
-	PhoneNumber string            `json:"phone_number"`
-	TemplateID  string            `json:"template_id"`
-	Title       *Title            `json:"title,omitempty"`
-	Data        *SendWhatsappData `json:"data,omitempty"`
-	Button      *Button           `json:"button,omitempty"`
-	Footer      *string           `json:"footer,omitempty"`
+	PhoneNumber    string            `json:"phone_number"`
+	TemplateID     string            `json:"template_id"`
+	Title          *Title            `json:"title,omitempty"`
+	Data           *SendWhatsappData `json:"data,omitempty"`
+	Button         *Button           `json:"button,omitempty"`
+	Footer         *string           `json:"footer,omitempty"`
+	IdempotencyKey string            `json:"-"`
 }
 
 type SendWhatsappData struct {
 	OrderID     string `json:"order_id"`
 	Description string `json:"description"`
 	Amount      string `json:"amount"`
+	Currency    string `json:"currency"`
 	ExpiredAt   string `json:"expired_at"`
 }
 
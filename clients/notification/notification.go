@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	clientConfig "order-service/clients/config"
+	"order-service/common/metrics"
 	"order-service/common/sentry"
 	"order-service/config"
 	"order-service/constant"
@@ -36,7 +38,7 @@ func (p *INotification) SendToWhatsapp(ctx context.Context, request *Notificatio
 	var (
 		span = p.sentry.StartSpan(ctx, logCtx)
 	)
-	p.sentry.SpanContext(span)
+	ctx = p.sentry.SpanContext(span)
 	defer p.sentry.Finish(span)
 
 	body, err := json.Marshal(request)
@@ -44,12 +46,19 @@ func (p *INotification) SendToWhatsapp(ctx context.Context, request *Notificatio
 		return err
 	}
 
-	clone := p.client.Client().Clone()
-	resp, bodyResp, errs := clone.
+	clone := p.client.Client().Clone().
 		Post(fmt.Sprintf("%s/api/v1/template/send-message", p.client.BaseURL())).
-		Set(constant.XApiKey, config.Config.InternalService.Notification.StaticKey).
+		Set(constant.XApiKey, config.Config.InternalService.Notification.StaticKey)
+	if request.IdempotencyKey != "" {
+		clone = clone.Set(constant.XIdempotencyKey, request.IdempotencyKey)
+	}
+
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+	start := time.Now()
+	resp, bodyResp, errs := clone.
 		Send(string(body)).
 		End()
+	metrics.ObserveClient("notification", "send_whatsapp", start, resp, errs)
 
 	if len(errs) > 0 {
 		return errs[0]
@@ -3,6 +3,7 @@ package clients
 import (
 	"github.com/google/uuid"
 
+	"order-service/common/money"
 	"order-service/constant"
 
 	"time"
@@ -11,10 +12,12 @@ import (
 type PaymentRequest struct {
 	OrderID        uuid.UUID                 `json:"order_id"`
 	ExpiredAt      time.Time                 `json:"expired_at"`
-	Amount         float64                   `json:"amount"`
+	Amount         money.Money               `json:"amount"`
+	Currency       constant.Currency         `json:"currency"`
 	Description    constant.PaymentTypeTitle `json:"description"`
 	CustomerDetail CustomerDetail            `json:"customer_details"`
 	ItemDetail     []ItemDetail              `json:"item_details"`
+	IdempotencyKey string                    `json:"-"`
 }
 
 type CustomerDetail struct {
@@ -26,7 +29,7 @@ type CustomerDetail struct {
 type ItemDetail struct {
 	ID       uuid.UUID                 `json:"id"`
 	Name     constant.PaymentTypeTitle `json:"name"`
-	Amount   float64                   `json:"amount"`
+	Amount   money.Money               `json:"amount"`
 	Quantity int                       `json:"quantity"`
 }
 
@@ -45,20 +48,44 @@ type PaymentResponse struct {
 }
 
 type PaymentData struct {
-	ID            int        `json:"id"`
-	UUID          uuid.UUID  `json:"uuid"`
-	OrderID       string     `json:"order_id"`
-	Amount        float64    `json:"amount"`
-	PaymentLink   string     `json:"payment_link"`
-	Description   string     `json:"description"`
-	ExpiredAt     time.Time  `json:"expired_at"`
-	CreatedAt     *time.Time `json:"created_at"`
-	Status        *string    `json:"status"`
-	PaymentType   *string    `json:"payment_type"`
-	VANumber      *string    `json:"va_number"`
-	Bank          *string    `json:"bank"`
-	Acquirer      *string    `json:"acquirer"`
-	TransactionID *string    `json:"transaction_id"`
-	BillerCode    *string    `json:"biller_code"`
-	UpdatedAt     *time.Time `json:"updated_at"`
+	ID            int         `json:"id"`
+	UUID          uuid.UUID   `json:"uuid"`
+	OrderID       string      `json:"order_id"`
+	Amount        money.Money `json:"amount"`
+	PaymentLink   string      `json:"payment_link"`
+	Description   string      `json:"description"`
+	ExpiredAt     time.Time   `json:"expired_at"`
+	CreatedAt     *time.Time  `json:"created_at"`
+	Status        *string     `json:"status"`
+	PaymentType   *string     `json:"payment_type"`
+	VANumber      *string     `json:"va_number"`
+	Bank          *string     `json:"bank"`
+	Acquirer      *string     `json:"acquirer"`
+	TransactionID *string     `json:"transaction_id"`
+	BillerCode    *string     `json:"biller_code"`
+	PaidAt        *time.Time  `json:"paid_at"`
+	UpdatedAt     *time.Time  `json:"updated_at"`
+}
+
+type RefundRequest struct {
+	PaymentID      uuid.UUID   `json:"-"`
+	Amount         money.Money `json:"amount"`
+	Reason         string      `json:"reason"`
+	IdempotencyKey string      `json:"-"`
+}
+
+type RefundResponse struct {
+	Code    int          `json:"code"`
+	Status  string       `json:"status"`
+	Message string       `json:"message"`
+	Data    RefundData   `json:"data"`
+	Error   *interface{} `json:"error,omitempty"`
+}
+
+type RefundData struct {
+	UUID       uuid.UUID   `json:"uuid"`
+	PaymentID  uuid.UUID   `json:"payment_id"`
+	Amount     money.Money `json:"amount"`
+	Status     *string     `json:"status"`
+	RefundedAt *time.Time  `json:"refunded_at"`
 }
@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
+
 	clientConfig "order-service/clients/config"
+	"order-service/common/metrics"
 	"order-service/common/sentry"
 	"order-service/config"
 	"order-service/constant"
@@ -21,6 +24,9 @@ type IPayment struct {
 
 type IPaymentClient interface {
 	CreatePaymentLink(context.Context, *PaymentRequest) (*PaymentData, error)
+	Refund(context.Context, *RefundRequest) (*RefundData, error)
+	GetPaymentStatus(context.Context, uuid.UUID) (*PaymentData, error)
+	ExpirePayment(context.Context, uuid.UUID) error
 }
 
 func NewPaymentClient(
@@ -38,7 +44,7 @@ func (p *IPayment) CreatePaymentLink(ctx context.Context, request *PaymentReques
 	var (
 		span = p.sentry.StartSpan(ctx, logCtx)
 	)
-	p.sentry.SpanContext(span)
+	ctx = p.sentry.SpanContext(span)
 	defer p.sentry.Finish(span)
 
 	unixTime := time.Now().Unix()
@@ -53,14 +59,21 @@ func (p *IPayment) CreatePaymentLink(ctx context.Context, request *PaymentReques
 		return nil, err
 	}
 
-	clone := p.client.Client().Clone()
-	resp, bodyResp, errs := clone.
+	clone := p.client.Client().Clone().
 		Post(fmt.Sprintf("%s/api/v1/payment", p.client.BaseURL())).
 		Set(constant.XServiceName, config.Config.AppName).
 		Set(constant.XApiKey, apiKey).
-		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime)).
+		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime))
+	if request.IdempotencyKey != "" {
+		clone = clone.Set(constant.XIdempotencyKey, request.IdempotencyKey)
+	}
+
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+	start := time.Now()
+	resp, bodyResp, errs := clone.
 		Send(string(body)).
 		End()
+	metrics.ObserveClient("payment", "create_payment_link", start, resp, errs)
 
 	if len(errs) > 0 {
 		return nil, errs[0]
@@ -84,3 +97,157 @@ func (p *IPayment) CreatePaymentLink(ctx context.Context, request *PaymentReques
 
 	return &response.Data, nil
 }
+
+func (p *IPayment) Refund(ctx context.Context, request *RefundRequest) (*RefundData, error) {
+	logCtx := "common.clients.payment.payment.Refund"
+	var (
+		span = p.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = p.sentry.SpanContext(span)
+	defer p.sentry.Finish(span)
+
+	unixTime := time.Now().Unix()
+	generateAPIKey := fmt.Sprintf("%s:%s:%d",
+		config.Config.AppName,
+		p.client.SecretKey(),
+		unixTime)
+	apiKey := helper.GenerateSHA256(generateAPIKey)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := p.client.Client().Clone().
+		Post(fmt.Sprintf("%s/api/v1/payment/%s/refund", p.client.BaseURL(), request.PaymentID)).
+		Set(constant.XServiceName, config.Config.AppName).
+		Set(constant.XApiKey, apiKey).
+		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime))
+	if request.IdempotencyKey != "" {
+		clone = clone.Set(constant.XIdempotencyKey, request.IdempotencyKey)
+	}
+
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+	start := time.Now()
+	resp, bodyResp, errs := clone.
+		Send(string(body)).
+		End()
+	metrics.ObserveClient("payment", "refund", start, resp, errs)
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	var errResponse ErrorPaymentResponse
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		err = json.Unmarshal([]byte(bodyResp), &errResponse)
+		if err != nil {
+			return nil, err
+		}
+		paymentError := fmt.Errorf("payment response: %s", errResponse.Message) //nolint:goerr113
+		return nil, paymentError
+	}
+
+	var response RefundResponse
+	err = json.Unmarshal([]byte(bodyResp), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
+
+func (p *IPayment) GetPaymentStatus(ctx context.Context, paymentID uuid.UUID) (*PaymentData, error) {
+	logCtx := "common.clients.payment.payment.GetPaymentStatus"
+	var (
+		span = p.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = p.sentry.SpanContext(span)
+	defer p.sentry.Finish(span)
+
+	unixTime := time.Now().Unix()
+	generateAPIKey := fmt.Sprintf("%s:%s:%d",
+		config.Config.AppName,
+		p.client.SecretKey(),
+		unixTime)
+	apiKey := helper.GenerateSHA256(generateAPIKey)
+
+	clone := p.client.Client().Clone().
+		Get(fmt.Sprintf("%s/api/v1/payment/%s", p.client.BaseURL(), paymentID))
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+
+	start := time.Now()
+	resp, bodyResp, errs := clone.
+		Set(constant.XServiceName, config.Config.AppName).
+		Set(constant.XApiKey, apiKey).
+		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime)).
+		End()
+	metrics.ObserveClient("payment", "get_payment_status", start, resp, errs)
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	var errResponse ErrorPaymentResponse
+	if resp.StatusCode != http.StatusOK {
+		err := json.Unmarshal([]byte(bodyResp), &errResponse)
+		if err != nil {
+			return nil, err
+		}
+		paymentError := fmt.Errorf("payment response: %s", errResponse.Message) //nolint:goerr113
+		return nil, paymentError
+	}
+
+	var response PaymentResponse
+	err := json.Unmarshal([]byte(bodyResp), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
+
+// ExpirePayment voids a payment link that has not been paid yet, the payment service rejects the
+// request when the payment is already settled.
+func (p *IPayment) ExpirePayment(ctx context.Context, paymentID uuid.UUID) error {
+	logCtx := "common.clients.payment.payment.ExpirePayment"
+	var (
+		span = p.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = p.sentry.SpanContext(span)
+	defer p.sentry.Finish(span)
+
+	unixTime := time.Now().Unix()
+	generateAPIKey := fmt.Sprintf("%s:%s:%d",
+		config.Config.AppName,
+		p.client.SecretKey(),
+		unixTime)
+	apiKey := helper.GenerateSHA256(generateAPIKey)
+
+	clone := p.client.Client().Clone().
+		Post(fmt.Sprintf("%s/api/v1/payment/%s/expire", p.client.BaseURL(), paymentID)).
+		Set(constant.XServiceName, config.Config.AppName).
+		Set(constant.XApiKey, apiKey).
+		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime))
+	clone = clientConfig.SetHeaders(clone, p.sentry.Inject(ctx))
+
+	start := time.Now()
+	resp, bodyResp, errs := clone.End()
+	metrics.ObserveClient("payment", "expire_payment", start, resp, errs)
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	var errResponse ErrorPaymentResponse
+	if resp.StatusCode != http.StatusOK {
+		err := json.Unmarshal([]byte(bodyResp), &errResponse)
+		if err != nil {
+			return err
+		}
+		paymentError := fmt.Errorf("payment response: %s", errResponse.Message) //nolint:goerr113
+		return paymentError
+	}
+
+	return nil
+}
@@ -37,6 +37,7 @@ type PackageData struct {
 	Name               string       `json:"name"`
 	Description        string       `json:"description"`
 	Price              int          `json:"price"`
+	Currency           string       `json:"currency"`
 	Pack               int          `json:"pack"`
 	MinimalDownPayment int          `json:"minimalDownPayment"`
 	IsActive           bool         `json:"isActive"`
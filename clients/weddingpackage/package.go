@@ -7,6 +7,7 @@ import (
 	"time"
 
 	clientConfig "order-service/clients/config"
+	"order-service/common/metrics"
 	"order-service/common/sentry"
 	"order-service/config"
 	"order-service/constant"
@@ -34,7 +35,7 @@ func (i *IWeddingPackage) GetDetailPackage(ctx context.Context, uuid string) (*P
 	var (
 		span = i.sentry.StartSpan(ctx, logCtx)
 	)
-	i.sentry.SpanContext(span)
+	ctx = i.sentry.SpanContext(span)
 	defer i.sentry.Finish(span)
 
 	unixTime := time.Now().Unix()
@@ -50,8 +51,11 @@ func (i *IWeddingPackage) GetDetailPackage(ctx context.Context, uuid string) (*P
 		Set(constant.XApiKey, apiKey).
 		Set(constant.XRequestAt, fmt.Sprintf("%d", unixTime)).
 		Get(fmt.Sprintf("%s/api/v1/package/%s", i.client.BaseURL(), uuid))
+	clone = clientConfig.SetHeaders(clone, i.sentry.Inject(ctx))
 
+	start := time.Now()
 	resp, _, errs := clone.EndStruct(&response)
+	metrics.ObserveClient("package", "get_detail_package", start, resp, errs)
 	if len(errs) > 0 {
 		return nil, errs[0]
 	}
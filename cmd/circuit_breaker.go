@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"time"
+
+	"order-service/common/circuitbreaker"
+	"order-service/common/sentry"
+	"order-service/config"
+)
+
+// newCircuitBreaker registers a breaker per downstream service, the global settings are the
+// defaults of the services without an entry in circuitBreakers.
+func newCircuitBreaker(sentry sentry.ISentry) circuitbreaker.ICircuitBreaker {
+	options := []circuitbreaker.Option{
+		circuitbreaker.WithMaxRequest(config.Config.CircuitBreakerMaxRequest),
+		circuitbreaker.WithTimeout(config.Config.CircuitBreakerTimeoutInSecond),
+	}
+	for name, breaker := range config.Config.CircuitBreakers {
+		options = append(options, circuitbreaker.WithBreaker(name, circuitbreaker.Settings{
+			MaxRequests: breaker.MaxRequest,
+			Interval:    time.Duration(breaker.IntervalInSecond) * time.Second,
+			Timeout:     time.Duration(breaker.TimeoutInSecond) * time.Second,
+			TripRatio:   breaker.TripRatio,
+			MinRequests: breaker.MinRequest,
+		}))
+	}
+
+	return circuitbreaker.NewCircuitBreaker(sentry, options...)
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"order-service/config"
+	subOrderService "order-service/services/suborder"
+)
+
+func runExpirySweeper(ctx context.Context, subOrder subOrderService.ISubOrderService) {
+	interval := time.Duration(config.Config.ExpirySweeper.IntervalInSecond) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := subOrder.ExpireOverdue(ctx)
+			if err != nil {
+				log.Errorf("error expiring overdue sub orders: %v", err)
+				continue
+			}
+
+			if expired > 0 {
+				log.Infof("expired %d overdue sub orders", expired)
+			}
+		}
+	}
+}
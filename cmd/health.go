@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"order-service/common/circuitbreaker"
+	"order-service/common/health"
+	"order-service/config"
+	constantError "order-service/constant/error"
+	kafkaConfig "order-service/controllers/kafka/config"
+	"order-service/utils/response"
+)
+
+func newHealth(
+	db *gorm.DB,
+	consumer *kafkaConfig.ConsumerGroup,
+	topics []string,
+	breaker circuitbreaker.ICircuitBreaker,
+) health.IHealth {
+	return health.NewHealth(
+		health.WithReadinessCheck("database", databaseCheck(db)),
+		health.WithReadinessCheck("kafkaConsumer", consumerCheck(consumer, topics)),
+		health.WithCheck("circuitBreaker", breakerCheck(breaker)),
+		health.WithCheck("config", configCheck()),
+	)
+}
+
+// registerHealthRoutes exposes the probes without authentication, /readyz answers 503 until
+// the database is reachable and the kafka consumer has joined its group.
+func registerHealthRoutes(router *gin.Engine, checker health.IHealth) {
+	router.GET("/healthz", func(c *gin.Context) {
+		healthResponse(c, checker.Live(c.Request.Context()))
+	})
+	router.GET("/readyz", func(c *gin.Context) {
+		healthResponse(c, checker.Ready(c.Request.Context()))
+	})
+}
+
+func healthResponse(c *gin.Context, report health.Report) {
+	if report.Status != health.StatusUp {
+		c.JSON(http.StatusServiceUnavailable, response.Response{
+			Status:  constantError.Error,
+			Message: http.StatusText(http.StatusServiceUnavailable),
+			Data:    report,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Status:  constantError.Success,
+		Message: http.StatusText(http.StatusOK),
+		Data:    report,
+	})
+}
+
+func databaseCheck(db *gorm.DB) health.Check {
+	return func(ctx context.Context) health.Component {
+		sqlDB, err := db.DB()
+		if err == nil {
+			err = sqlDB.PingContext(ctx)
+		}
+		if err != nil {
+			return health.Component{Status: health.StatusDown, Error: err.Error()}
+		}
+
+		stats := sqlDB.Stats()
+		return health.Component{
+			Status: health.StatusUp,
+			Detail: map[string]int{
+				"openConnections": stats.OpenConnections,
+				"inUse":           stats.InUse,
+				"idle":            stats.Idle,
+			},
+		}
+	}
+}
+
+func consumerCheck(consumer *kafkaConfig.ConsumerGroup, topics []string) health.Check {
+	return func(context.Context) health.Component {
+		if len(topics) == 0 {
+			return health.Component{
+				Status: health.StatusUp,
+				Detail: map[string]interface{}{"enabled": false},
+			}
+		}
+
+		joined, claims := consumer.Status()
+		status := health.StatusUp
+		if !joined {
+			status = health.StatusDown
+		}
+		return health.Component{
+			Status: status,
+			Detail: map[string]interface{}{
+				"enabled":    true,
+				"joined":     joined,
+				"topics":     topics,
+				"partitions": claims,
+			},
+		}
+	}
+}
+
+// breakerCheck only reports the breaker states, an open breaker means a downstream is failing
+// while the service itself can still serve the other requests.
+func breakerCheck(breaker circuitbreaker.ICircuitBreaker) health.Check {
+	return func(context.Context) health.Component {
+		return health.Component{
+			Status: health.StatusUp,
+			Detail: breaker.States(),
+		}
+	}
+}
+
+func configCheck() health.Check {
+	return func(context.Context) health.Component {
+		return health.Component{
+			Status: health.StatusUp,
+			Detail: map[string]string{
+				"source": config.Source,
+				"env":    config.Config.AppEnv,
+			},
+		}
+	}
+}
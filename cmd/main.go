@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -27,8 +28,8 @@ import (
 
 	kafkaConfig "order-service/controllers/kafka/config"
 
-	"order-service/common/circuitbreaker"
-	"order-service/common/sentry"
+	"order-service/common/kafka"
+	"order-service/common/metrics"
 	"order-service/config"
 	"order-service/domain/models"
 	"order-service/middlewares"
@@ -68,31 +69,57 @@ var restCmd = &cobra.Command{
 			&models.OrderHistory{},
 			&models.OrderPayment{},
 			&models.OrderInvoice{},
+			&models.OrderOutbox{},
+			&models.OrderRefund{},
+			&models.ParkedMessage{},
+			&models.ProcessedEvent{},
+			&models.InstallmentPlan{},
+			&models.InstallmentPlanItem{},
+			&models.PackageInstallmentPlan{},
+			&models.Voucher{},
+			&models.VoucherPackage{},
+			&models.VoucherRedemption{},
+			&models.PaymentReminder{},
 		)
 		if err != nil {
 			panic(err)
 		}
 
-		// Sentry for error tracking
-		sentry := sentry.NewSentry(
-			sentry.WithDsn(config.Config.SentryDsn),
-			sentry.WithDebug(config.Config.AppDebug),
-			sentry.WithEnv(config.Config.AppEnv),
-			sentry.WithSampleRate(config.Config.SentrySampleRate),
-			sentry.WithEnableTracing(config.Config.SentryEnableTracing),
-		)
+		// Sentry for error tracking, spans go to sentry or an OTLP collector
+		sentry := newTracer()
 
 		// Circuit Breaker
-		circuitBreaker := circuitbreaker.NewCircuitBreaker(
+		circuitBreaker := newCircuitBreaker(sentry)
+
+		// Kafka Producer
+		producer, err := kafka.NewProducer(
+			config.Config.KafkaHosts,
 			sentry,
-			circuitbreaker.WithMaxRequest(config.Config.CircuitBreakerMaxRequest),
-			circuitbreaker.WithTimeout(config.Config.CircuitBreakerTimeoutInSecond),
+			kafka.WithTimeout(config.Config.KafkaTimeoutInMs),
+			kafka.WithMaxRetry(config.Config.KafkaMaxRetry),
 		)
+		if err != nil {
+			panic(err)
+		}
+		defer func() {
+			if errClose := producer.Close(); errClose != nil {
+				log.Error(fmt.Sprintf("error closing producer: %v", errClose))
+			}
+		}()
 
 		client := clientRegistry.NewClientRegistry(sentry)
 		repository := repositoryRegistry.NewRepositoryRegistry(db, sentry)
-		service := serviceRegistry.NewServiceRegistry(repository, client, sentry, circuitBreaker)
-		controller := controllerRegistry.NewControllerRegistry(service, sentry)
+		service := serviceRegistry.NewServiceRegistry(repository, client, sentry, circuitBreaker, producer)
+		kafkaHandler := kafkaRegistry.NewKafkaRegistry(service, sentry)
+		controller := controllerRegistry.NewControllerRegistry(service, kafkaHandler, sentry)
+
+		topics := config.Config.KafkaConsumerTopics
+		consumer := kafkaConfig.NewConsumer(
+			kafkaConfig.WithDeadLetterHandler(kafkaHandler.GetDeadLetter().HandleDeadLetter),
+			kafkaConfig.WithSentry(sentry),
+		)
+		kafkaConsumer := kafkaConfig.NewKafkaRouter(consumer, kafkaHandler)
+		kafkaConsumer.Register()
 
 		router := gin.Default()
 		router.NoRoute(func(c *gin.Context) {
@@ -108,6 +135,11 @@ var restCmd = &cobra.Command{
 				DefaultExpirationTTL: time.Duration(config.Config.RateLimiterTimeSecond) * time.Second,
 			},
 		)
+		registerHealthRoutes(router, newHealth(db, consumer, topics, circuitBreaker))
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+		router.Use(middlewares.Metrics())
+		router.Use(middlewares.Trace(sentry))
+		router.Use(middlewares.Localize())
 		router.Use(middlewares.RateLimiter(lmt))
 		router.GET("/", func(c *gin.Context) {
 			c.JSON(http.StatusOK, response.Response{
@@ -127,16 +159,42 @@ var restCmd = &cobra.Command{
 		route := routeRegistry.NewRouteRegistry(controller, group)
 		route.Serve()
 
+		server := newHTTPServer(router)
 		go func() {
-			port := fmt.Sprintf(":%d", config.Config.Port)
-			err := router.Run(port)
-			if err != nil {
+			err := server.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
 				panic(err)
 			}
 		}()
 
-		// Kafka Consumer
+		// Register the signal handler before starting anything that may block, a signal received
+		// while the consumer is still joining its group must not kill the process ungracefully.
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
 		ctx, cancel := context.WithCancel(context.Background())
+		wg := sync.WaitGroup{}
+		background := func(run func()) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				run()
+			}()
+		}
+
+		// Outbox Dispatcher
+		background(func() { runOutboxDispatcher(ctx, service.GetOutbox()) })
+
+		// Expiry Sweeper
+		background(func() { runExpirySweeper(ctx, service.GetSubOrder()) })
+
+		// Payment Reminder
+		background(func() { runPaymentReminder(ctx, service.GetPaymentReminder()) })
+
+		// Processed Event Cleaner
+		background(func() { runProcessedEventCleaner(ctx, repository.GetProcessedEvent()) })
+
+		// Kafka Consumer
 		kafkaConsumerConfig := sarama.NewConfig()
 		kafkaConsumerConfig.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{
 			sarama.NewBalanceStrategyRoundRobin()}
@@ -157,29 +215,7 @@ var restCmd = &cobra.Command{
 			}
 		}()
 
-		brokers := config.Config.KafkaHosts
-		groupID := config.Config.KafkaConsumerGroupID
-		topics := config.Config.KafkaConsumerTopics
-		wg := sync.WaitGroup{}
-		wg.Add(1)
-
 		if len(topics) > 0 {
-			client, err := sarama.NewConsumerGroup(brokers, groupID, kafkaConsumerConfig)
-			if err != nil {
-				log.Fatal(ctx, fmt.Sprintf("error creating consumer group client: %v", err))
-			}
-
-			defer func() {
-				if err := client.Close(); err != nil {
-					log.Error(ctx, fmt.Sprintf("error closing client: %v", err))
-				}
-			}()
-
-			consumer := kafkaConfig.NewConsumer()
-			kafkaRegistry := kafkaRegistry.NewKafkaRegistry(service, sentry)
-			kafkaConsumer := kafkaConfig.NewKafkaRouter(consumer, kafkaRegistry)
-			kafkaConsumer.Register()
-
 			KafkaConsumerGroupID, errClient := sarama.NewConsumerGroupFromClient(
 				config.Config.KafkaConsumerGroupID,
 				kafkaConsumerClient,
@@ -188,6 +224,7 @@ var restCmd = &cobra.Command{
 				panic(errClient)
 			}
 
+			wg.Add(1)
 			go func() {
 				defer wg.Done()
 				defer func() {
@@ -203,23 +240,23 @@ var restCmd = &cobra.Command{
 						return
 					}
 
+					if ctx.Err() != nil {
+						return
+					}
+
 					if !consumer.KeepRunning() {
 						log.Error(ctx, "Consumer is not running anymore")
 						return
 					}
 				}
 			}()
-
-			consumer.SetIsReady()
-
-			// Wait for OS signals to gracefully shut down the consumer
-			sigChan := make(chan os.Signal, 1)
-			signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-			<-sigChan
 		}
 
-		cancel()
-		wg.Wait()
+		// Wait for OS signals to gracefully shut down the server and the consumer, readiness of the
+		// consumer is reported by /readyz instead of blocking here.
+		<-sigChan
+
+		shutdown(server, cancel, &wg, sentry, db)
 	},
 }
 
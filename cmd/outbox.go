@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"order-service/config"
+	outboxService "order-service/services/outbox"
+)
+
+func runOutboxDispatcher(ctx context.Context, outbox outboxService.IOutboxService) {
+	interval := time.Duration(config.Config.Outbox.DispatchIntervalInSecond) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := outbox.DispatchPending(ctx)
+			if err != nil {
+				log.Errorf("error dispatching outbox: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"order-service/config"
+	paymentReminderService "order-service/services/paymentreminder"
+)
+
+func runPaymentReminder(ctx context.Context, paymentReminder paymentReminderService.IPaymentReminderService) {
+	interval := time.Duration(config.Config.PaymentReminder.IntervalInSecond) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := paymentReminder.SendDueReminders(ctx)
+			if err != nil {
+				log.Errorf("error sending payment reminders: %v", err)
+				continue
+			}
+
+			if sent > 0 {
+				log.Infof("queued %d payment reminders", sent)
+			}
+		}
+	}
+}
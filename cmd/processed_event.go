@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	processedEventRepo "order-service/repositories/processedevent"
+)
+
+func runProcessedEventCleaner(ctx context.Context, processedEvent processedEventRepo.IProcessedEventRepository) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := processedEvent.DeleteExpired(ctx)
+			if err != nil {
+				log.Errorf("error deleting expired processed events: %v", err)
+				continue
+			}
+			log.Infof("deleted %d expired processed events", deleted)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	clientRegistry "order-service/clients"
+	"order-service/common/kafka"
+	"order-service/config"
+	"order-service/constant"
+	reconciliationDTO "order-service/domain/dto/reconciliation"
+	repositoryRegistry "order-service/repositories"
+	serviceRegistry "order-service/services"
+)
+
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Command to reconcile unsettled payments with the payment service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")     //nolint:errcheck
+		output, _ := cmd.Flags().GetString("output")     //nolint:errcheck
+		batchSize, _ := cmd.Flags().GetInt("batch-size") //nolint:errcheck
+		dryRun, _ := cmd.Flags().GetBool("dry-run")      //nolint:errcheck
+		if format != constant.ReconciliationFormatJSON && format != constant.ReconciliationFormatCSV {
+			return fmt.Errorf("unsupported report format %s", format) //nolint:goerr113
+		}
+
+		_ = godotenv.Load() //nolint:errcheck
+		config.Init()
+		db, err := config.InitDatabase()
+		if err != nil {
+			return err
+		}
+
+		loc, err := time.LoadLocation("Asia/Jakarta")
+		if err != nil {
+			return err
+		}
+		time.Local = loc
+
+		sentry := newTracer()
+		defer sentry.Flush(context.Background()) //nolint:errcheck
+
+		circuitBreaker := newCircuitBreaker(sentry)
+
+		producer, err := kafka.NewProducer(
+			config.Config.KafkaHosts,
+			sentry,
+			kafka.WithTimeout(config.Config.KafkaTimeoutInMs),
+			kafka.WithMaxRetry(config.Config.KafkaMaxRetry),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if errClose := producer.Close(); errClose != nil {
+				log.Error(fmt.Sprintf("error closing producer: %v", errClose))
+			}
+		}()
+
+		client := clientRegistry.NewClientRegistry(sentry)
+		repository := repositoryRegistry.NewRepositoryRegistry(db, sentry)
+		service := serviceRegistry.NewServiceRegistry(repository, client, sentry, circuitBreaker, producer)
+
+		report, err := service.GetReconciliation().Reconcile(context.Background(), &reconciliationDTO.ReconciliationRequest{
+			BatchSize: batchSize,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			return err
+		}
+
+		writer := io.Writer(os.Stdout)
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close() //nolint:errcheck
+			writer = file
+		}
+
+		log.Infof("reconciled %d payments, %d mismatched, %d applied, %d failed",
+			report.Checked, report.Mismatched, report.Applied, report.Failed)
+		if format == constant.ReconciliationFormatCSV {
+			return writeReconciliationCSV(writer, report)
+		}
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	},
+}
+
+func init() {
+	reconcileCmd.Flags().String("format", constant.ReconciliationFormatJSON, "report format, json or csv")
+	reconcileCmd.Flags().String("output", "", "write the report to a file instead of stdout")
+	reconcileCmd.Flags().Int("batch-size", 100, "number of payments loaded per page")
+	reconcileCmd.Flags().Bool("dry-run", false, "only report the mismatches without applying them")
+	restCmd.AddCommand(reconcileCmd)
+}
+
+func writeReconciliationCSV(writer io.Writer, report *reconciliationDTO.ReconciliationReport) error {
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write([]string{
+		"payment_id", "sub_order_id", "sub_order_status", "amount",
+		"local_status", "remote_status", "action", "error",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range report.Items {
+		err = csvWriter.Write([]string{
+			item.PaymentID.String(),
+			item.SubOrderID.String(),
+			item.SubOrderStatus.String(),
+			item.Amount.String(),
+			item.LocalStatus,
+			item.RemoteStatus,
+			item.Action.String(),
+			item.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
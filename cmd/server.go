@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"order-service/common/sentry"
+	"order-service/config"
+)
+
+func newHTTPServer(handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", config.Config.Port),
+		Handler:           handler,
+		ReadTimeout:       seconds(config.Config.HTTPServer.ReadTimeoutInSecond, 15),
+		ReadHeaderTimeout: seconds(config.Config.HTTPServer.ReadTimeoutInSecond, 15),
+		WriteTimeout:      seconds(config.Config.HTTPServer.WriteTimeoutInSecond, 30),
+		IdleTimeout:       seconds(config.Config.HTTPServer.IdleTimeoutInSecond, 60),
+	}
+}
+
+// shutdown stops accepting requests and drains the in-flight ones, then cancels the kafka consumer
+// and the background workers and waits for the message being handled before flushing sentry and
+// closing the database pool. Every step shares the same deadline.
+func shutdown(
+	server *http.Server,
+	cancel context.CancelFunc,
+	wg *sync.WaitGroup,
+	tracer sentry.ISentry,
+	db *gorm.DB,
+) {
+	ctx, cancelTimeout := context.WithTimeout(
+		context.Background(),
+		seconds(config.Config.HTTPServer.ShutdownTimeoutInSecond, 30),
+	)
+	defer cancelTimeout()
+
+	log.Infof("shutting down http server")
+	err := server.Shutdown(ctx)
+	if err != nil {
+		log.Errorf("error shutting down http server: %v", err)
+	}
+
+	log.Infof("waiting for kafka consumer and workers")
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Errorf("kafka consumer and workers did not stop before the shutdown deadline")
+	}
+
+	err = tracer.Flush(ctx)
+	if err != nil {
+		log.Errorf("error flushing sentry: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Errorf("error getting database pool: %v", err)
+		return
+	}
+	err = sqlDB.Close()
+	if err != nil {
+		log.Errorf("error closing database pool: %v", err)
+	}
+}
+
+func seconds(value, fallback int) time.Duration {
+	if value <= 0 {
+		value = fallback
+	}
+	return time.Duration(value) * time.Second
+}
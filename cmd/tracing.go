@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"order-service/common/sentry"
+	"order-service/config"
+)
+
+// newTracer keeps sentry as the default span exporter, with the otel provider spans are sent over
+// OTLP and sentry only receives the captured exceptions.
+func newTracer() sentry.ISentry {
+	if config.Config.Tracing.Provider == config.TracingOtel {
+		return sentry.NewOpenTelemetry(
+			sentry.WithOtlpEndpoint(config.Config.Tracing.OtlpEndpoint),
+			sentry.WithOtlpInsecure(config.Config.Tracing.OtlpInsecure),
+			sentry.WithServiceName(config.Config.AppName),
+			sentry.WithTelemetryEnv(config.Config.AppEnv),
+			sentry.WithTelemetrySampleRate(config.Config.Tracing.SampleRate),
+			sentry.WithErrorDsn(config.Config.SentryDsn),
+		)
+	}
+
+	return sentry.NewSentry(
+		sentry.WithDsn(config.Config.SentryDsn),
+		sentry.WithDebug(config.Config.AppDebug),
+		sentry.WithEnv(config.Config.AppEnv),
+		sentry.WithSampleRate(config.Config.SentrySampleRate),
+		sentry.WithEnableTracing(config.Config.SentryEnableTracing),
+	)
+}
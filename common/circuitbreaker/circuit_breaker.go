@@ -2,66 +2,163 @@ package circuitbreaker
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/sony/gobreaker"
 
+	"order-service/common/metrics"
 	"order-service/common/sentry"
 	errCircuitBreaker "order-service/constant/error"
 )
 
+// Names of the downstream services, each one gets its own breaker so an outage of one of them
+// does not block the calls to the others.
+const (
+	Package      = "package"
+	Payment      = "payment"
+	Invoice      = "invoice"
+	Notification = "notification"
+)
+
+const defaultMinRequests = 10
+
+// Settings of a single breaker, zero values fall back to the defaults of the registry. A breaker
+// without a trip ratio keeps the gobreaker default of tripping after more than five consecutive
+// failures.
+type Settings struct {
+	MaxRequests uint32
+	Interval    time.Duration
+	Timeout     time.Duration
+	TripRatio   float64
+	MinRequests uint32
+}
+
 type CircuitBreaker struct {
-	name        string
-	maxRequests uint32
-	timeout     uint32
-	cb          *gobreaker.CircuitBreaker
-	sentry      sentry.ISentry
+	mu       *sync.Mutex
+	defaults Settings
+	settings map[string]Settings
+	breakers map[string]*gobreaker.CircuitBreaker
+	sentry   sentry.ISentry
 }
 
 type BreakerFunc func() (interface{}, error)
 type Option func(*CircuitBreaker)
 
 type ICircuitBreaker interface {
-	Execute(context.Context, BreakerFunc) error
+	Execute(context.Context, string, BreakerFunc) error
+	States() map[string]string
 }
 
 func WithMaxRequest(maxRequest uint32) Option {
 	return func(c *CircuitBreaker) {
-		c.maxRequests = maxRequest
+		c.defaults.MaxRequests = maxRequest
 	}
 }
 
 func WithTimeout(timeout uint32) Option {
 	return func(c *CircuitBreaker) {
-		c.timeout = timeout
+		c.defaults.Timeout = time.Duration(timeout) * time.Second
+	}
+}
+
+func WithBreaker(name string, settings Settings) Option {
+	return func(c *CircuitBreaker) {
+		c.settings[name] = settings
 	}
 }
 
 func NewCircuitBreaker(sentry sentry.ISentry, options ...Option) ICircuitBreaker {
 	circuitBreaker := &CircuitBreaker{
-		name:   "circuit-breaker",
-		sentry: sentry,
+		mu:       &sync.Mutex{},
+		settings: make(map[string]Settings),
+		breakers: make(map[string]*gobreaker.CircuitBreaker),
+		sentry:   sentry,
 	}
 
 	for _, option := range options {
 		option(circuitBreaker)
 	}
 
-	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
-		Name:        circuitBreaker.name,
-		MaxRequests: circuitBreaker.maxRequests,
-		Interval:    time.Duration(circuitBreaker.timeout) * time.Second,
+	for _, name := range []string{Package, Payment, Invoice, Notification} {
+		circuitBreaker.register(name)
+	}
+	for name := range circuitBreaker.settings {
+		circuitBreaker.register(name)
+	}
+
+	return circuitBreaker
+}
+
+// register creates the breaker of a downstream, only the known downstreams and the ones with
+// settings are registered so a typo in a name fails instead of getting a breaker of its own.
+func (c *CircuitBreaker) register(name string) {
+	if _, ok := c.breakers[name]; ok {
+		return
+	}
+
+	settings := c.settingsOf(name)
+	breakerSettings := gobreaker.Settings{
+		Name:        name,
+		MaxRequests: settings.MaxRequests,
+		Interval:    settings.Interval,
+		Timeout:     settings.Timeout,
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			log.Infof("Circuit Breaker state changed from %s to %s\n", from, to)
+			log.Infof("Circuit Breaker %s state changed from %s to %s\n", name, from, to)
+			metrics.CircuitBreakerTransitionsTotal.WithLabelValues(name, from.String(), to.String()).Inc()
+			metrics.CircuitBreakerState.WithLabelValues(name).Set(float64(to))
 		},
-	})
+	}
+	if settings.TripRatio > 0 {
+		breakerSettings.ReadyToTrip = func(counts gobreaker.Counts) bool {
+			ratio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= settings.MinRequests && ratio >= settings.TripRatio
+		}
+	}
 
-	circuitBreaker.cb = cb
-	return circuitBreaker
+	cb := gobreaker.NewCircuitBreaker(breakerSettings)
+	metrics.CircuitBreakerState.WithLabelValues(name).Set(float64(cb.State()))
+	c.breakers[name] = cb
 }
 
-func (c CircuitBreaker) Execute(ctx context.Context, client BreakerFunc) error {
+func (c *CircuitBreaker) settingsOf(name string) Settings {
+	settings := c.defaults
+	override := c.settings[name]
+	if override.MaxRequests > 0 {
+		settings.MaxRequests = override.MaxRequests
+	}
+	if override.Interval > 0 {
+		settings.Interval = override.Interval
+	}
+	if override.Timeout > 0 {
+		settings.Timeout = override.Timeout
+	}
+	if override.TripRatio > 0 {
+		settings.TripRatio = override.TripRatio
+	}
+	if override.MinRequests > 0 {
+		settings.MinRequests = override.MinRequests
+	}
+	if settings.MinRequests == 0 {
+		settings.MinRequests = defaultMinRequests
+	}
+	return settings
+}
+
+func (c *CircuitBreaker) States() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	states := make(map[string]string, len(c.breakers))
+	for name, cb := range c.breakers {
+		states[name] = cb.State().String()
+	}
+	return states
+}
+
+func (c *CircuitBreaker) Execute(ctx context.Context, name string, client BreakerFunc) error {
 	logCtx := "common.circuitbreaker.circuit_breaker.Execute"
 	var (
 		span = c.sentry.StartSpan(ctx, logCtx)
@@ -69,15 +166,21 @@ func (c CircuitBreaker) Execute(ctx context.Context, client BreakerFunc) error {
 	c.sentry.SpanContext(span)
 	defer c.sentry.Finish(span)
 
-	result, err := c.cb.Execute(client)
+	c.mu.Lock()
+	cb, ok := c.breakers[name]
+	c.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", errCircuitBreaker.ErrUnknownBreaker, name)
+	}
+
+	_, err := cb.Execute(client)
 	if err != nil {
-		if c.cb.State() == gobreaker.StateOpen {
+		if cb.State() == gobreaker.StateOpen {
 			return errCircuitBreaker.ErrOpenState
 		}
 
 		return err
 	}
 
-	log.Infof("Result: %v\n", result)
 	return nil
 }
@@ -0,0 +1,94 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	errCircuitBreaker "order-service/constant/error"
+	sentryMocks "order-service/mocks/common/sentry"
+)
+
+func TestExecute(t *testing.T) {
+	sentry := new(sentryMocks.ISentry)
+	sentry.On("StartSpan", mock.Anything, mock.Anything).Return(nil)
+	sentry.On("SpanContext", mock.Anything).Return(context.Background())
+	sentry.On("Finish", mock.Anything).Return()
+
+	errDownstream := errors.New("downstream failed")
+	breaker := NewCircuitBreaker(sentry, WithBreaker("reporting", Settings{TripRatio: 0.5}))
+
+	tests := []struct {
+		name    string
+		breaker string
+		fn      BreakerFunc
+		wantErr error
+	}{
+		{
+			name:    "known downstream",
+			breaker: Payment,
+			fn:      func() (interface{}, error) { return nil, nil },
+		},
+		{
+			name:    "configured breaker",
+			breaker: "reporting",
+			fn:      func() (interface{}, error) { return nil, nil },
+		},
+		{
+			name:    "downstream error",
+			breaker: Invoice,
+			fn:      func() (interface{}, error) { return nil, errDownstream },
+			wantErr: errDownstream,
+		},
+		{
+			name:    "unknown breaker",
+			breaker: "paymnet",
+			fn:      func() (interface{}, error) { return nil, nil },
+			wantErr: errCircuitBreaker.ErrUnknownBreaker,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := breaker.Execute(context.Background(), tt.breaker, tt.fn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute(%s) error = %v, want %v", tt.breaker, err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, ok := breaker.States()["paymnet"]; ok {
+		t.Errorf("States() registered the unknown breaker")
+	}
+
+	t.Run("trip ratio opens only its breaker", func(t *testing.T) {
+		breaker := NewCircuitBreaker(sentry, WithBreaker(Notification, Settings{TripRatio: 0.5, MinRequests: 4}))
+		ctx := context.Background()
+
+		succeed := func() (interface{}, error) { return nil, nil }
+		fail := func() (interface{}, error) { return nil, errDownstream }
+		for _, fn := range []BreakerFunc{succeed, fail, succeed} {
+			if err := breaker.Execute(ctx, Notification, fn); errors.Is(err, errCircuitBreaker.ErrOpenState) {
+				t.Fatalf("Execute(%s) tripped below the minimum requests", Notification)
+			}
+		}
+		if err := breaker.Execute(ctx, Notification, fail); !errors.Is(err, errCircuitBreaker.ErrOpenState) {
+			t.Fatalf("Execute(%s) error = %v, want %v", Notification, err, errCircuitBreaker.ErrOpenState)
+		}
+
+		called := false
+		err := breaker.Execute(ctx, Notification, func() (interface{}, error) { called = true; return nil, nil })
+		if !errors.Is(err, errCircuitBreaker.ErrOpenState) || called {
+			t.Errorf("Execute(%s) error = %v, called = %v, want %v without calling", Notification, err,
+				called, errCircuitBreaker.ErrOpenState)
+		}
+		if err := breaker.Execute(ctx, Payment, succeed); err != nil {
+			t.Errorf("Execute(%s) error = %v, want nil", Payment, err)
+		}
+		if state := breaker.States()[Notification]; state != "open" {
+			t.Errorf("States()[%s] = %s, want open", Notification, state)
+		}
+	})
+}
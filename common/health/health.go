@@ -0,0 +1,126 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+
+	defaultTimeout = 3 * time.Second
+)
+
+type Component struct {
+	Status Status      `json:"status"`
+	Detail interface{} `json:"detail,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     Status               `json:"status"`
+	Components map[string]Component `json:"components"`
+}
+
+type Check func(context.Context) Component
+
+type check struct {
+	name       string
+	check      Check
+	gatesReady bool
+}
+
+type Health struct {
+	timeout time.Duration
+	checks  []check
+}
+
+type Option func(*Health)
+
+type IHealth interface {
+	Live(context.Context) Report
+	Ready(context.Context) Report
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Health) {
+		h.timeout = timeout
+	}
+}
+
+// WithCheck registers a component that is only reported, its failure does not make the service
+// unready.
+func WithCheck(name string, component Check) Option {
+	return func(h *Health) {
+		h.checks = append(h.checks, check{name: name, check: component})
+	}
+}
+
+// WithReadinessCheck registers a component the service cannot serve without, the service is
+// reported unready while it is down.
+func WithReadinessCheck(name string, component Check) Option {
+	return func(h *Health) {
+		h.checks = append(h.checks, check{name: name, check: component, gatesReady: true})
+	}
+}
+
+func NewHealth(options ...Option) IHealth {
+	health := &Health{
+		timeout: defaultTimeout,
+	}
+
+	for _, option := range options {
+		option(health)
+	}
+
+	return health
+}
+
+// Live reports every component, the process itself is alive as long as it is able to answer so
+// the status is always up.
+func (h *Health) Live(ctx context.Context) Report {
+	report := h.run(ctx)
+	report.Status = StatusUp
+	return report
+}
+
+func (h *Health) Ready(ctx context.Context) Report {
+	report := h.run(ctx)
+	for _, check := range h.checks {
+		if check.gatesReady && report.Components[check.name].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+func (h *Health) run(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		components = make(map[string]Component, len(h.checks))
+	)
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func(name string, component Check) {
+			defer wg.Done()
+			result := component(ctx)
+			mu.Lock()
+			components[name] = result
+			mu.Unlock()
+		}(check.name, check.check)
+	}
+	wg.Wait()
+
+	return Report{
+		Status:     StatusUp,
+		Components: components,
+	}
+}
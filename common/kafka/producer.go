@@ -0,0 +1,131 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	log "github.com/sirupsen/logrus"
+
+	"order-service/common/sentry"
+	"order-service/constant"
+)
+
+type Producer struct {
+	brokers  []string
+	timeout  time.Duration
+	maxRetry int
+	producer sarama.SyncProducer
+	sentry   sentry.ISentry
+}
+
+type Message struct {
+	Topic   string
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+type Option func(*Producer)
+
+type IProducer interface {
+	Produce(context.Context, *Message) error
+	Close() error
+}
+
+func WithTimeout(timeoutInMs int) Option {
+	return func(p *Producer) {
+		p.timeout = time.Duration(timeoutInMs) * time.Millisecond
+	}
+}
+
+func WithMaxRetry(maxRetry int) Option {
+	return func(p *Producer) {
+		p.maxRetry = maxRetry
+	}
+}
+
+func NewProducer(brokers []string, sentry sentry.ISentry, options ...Option) (IProducer, error) {
+	producer := &Producer{
+		brokers: brokers,
+		sentry:  sentry,
+	}
+
+	for _, option := range options {
+		option(producer)
+	}
+
+	producerConfig := sarama.NewConfig()
+	producerConfig.Producer.RequiredAcks = sarama.WaitForAll
+	producerConfig.Producer.Idempotent = true
+	producerConfig.Producer.Return.Successes = true
+	producerConfig.Producer.Partitioner = sarama.NewHashPartitioner
+	producerConfig.Net.MaxOpenRequests = 1
+	if producer.timeout > 0 {
+		producerConfig.Producer.Timeout = producer.timeout
+	}
+	if producer.maxRetry > 0 {
+		producerConfig.Producer.Retry.Max = producer.maxRetry
+	}
+
+	syncProducer, err := sarama.NewSyncProducer(brokers, producerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	producer.producer = syncProducer
+	return producer, nil
+}
+
+func (p *Producer) Produce(ctx context.Context, message *Message) error {
+	const logCtx = "common.kafka.producer.Produce"
+	var (
+		span = p.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = p.sentry.SpanContext(span)
+	defer p.sentry.Finish(span)
+
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers)+1)
+	for key, value := range message.Headers {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+
+	requestID, ok := ctx.Value(constant.XRequestID).(string)
+	if _, exists := message.Headers[constant.XRequestID]; ok && !exists && requestID != "" {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(constant.XRequestID),
+			Value: []byte(requestID),
+		})
+	}
+
+	for key, value := range p.sentry.Inject(ctx) {
+		if _, exists := message.Headers[key]; exists {
+			continue
+		}
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+
+	partition, offset, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   message.Topic,
+		Key:     sarama.StringEncoder(message.Key),
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	})
+	if err != nil {
+		log.Errorf("failed to produce message to topic %s: %v", message.Topic, err)
+		return err
+	}
+
+	log.Infof("produced message to topic %s partition %d offset %d", message.Topic, partition, offset)
+	return nil
+}
+
+func (p *Producer) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,120 @@
+package locale
+
+import (
+	"time"
+
+	constantError "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
+)
+
+const (
+	PaymentLinkButton    = "button.payment_link"
+	InvoiceButton        = "button.invoice"
+	CreditNoteTitle      = "invoice.credit_note"
+	PromoDiscountTitle   = "invoice.promo_discount"
+	VoucherDiscountTitle = "invoice.voucher_discount"
+)
+
+var monthKeys = map[time.Month]string{
+	time.January:   "month.january",
+	time.February:  "month.february",
+	time.March:     "month.march",
+	time.April:     "month.april",
+	time.May:       "month.may",
+	time.June:      "month.june",
+	time.July:      "month.july",
+	time.August:    "month.august",
+	time.September: "month.september",
+	time.October:   "month.october",
+	time.November:  "month.november",
+	time.December:  "month.december",
+}
+
+var catalogs = map[Locale]map[string]string{
+	Indonesian: {
+		PaymentLinkButton:    "Link Pembayaran",
+		InvoiceButton:        "Invoice",
+		CreditNoteTitle:      "Pengembalian Dana",
+		PromoDiscountTitle:   "Diskon %s",
+		VoucherDiscountTitle: "Voucher %s",
+		"month.january":      "Januari",
+		"month.february":     "Februari",
+		"month.march":        "Maret",
+		"month.april":        "April",
+		"month.may":          "Mei",
+		"month.june":         "Juni",
+		"month.july":         "Juli",
+		"month.august":       "Agustus",
+		"month.september":    "September",
+		"month.october":      "Oktober",
+		"month.november":     "November",
+		"month.december":     "Desember",
+	},
+	English: {
+		PaymentLinkButton:    "Payment Link",
+		InvoiceButton:        "Invoice",
+		CreditNoteTitle:      "Refund",
+		PromoDiscountTitle:   "Discount %s",
+		VoucherDiscountTitle: "Voucher %s",
+		"month.january":      "January",
+		"month.february":     "February",
+		"month.march":        "March",
+		"month.april":        "April",
+		"month.may":          "May",
+		"month.june":         "June",
+		"month.july":         "July",
+		"month.august":       "August",
+		"month.september":    "September",
+		"month.october":      "October",
+		"month.november":     "November",
+		"month.december":     "December",
+	},
+}
+
+// errorCatalogs translates the API error messages, keyed by the English message of the error.
+// English needs no entry since it is the language the errors are declared in.
+var errorCatalogs = map[Locale]map[string]string{
+	Indonesian: {
+		constantError.ErrInvalidStatusTransition.Error(): "perubahan status tidak valid",
+		constantError.ErrSQLError.Error():                "server database gagal memproses permintaan, silakan coba lagi",
+		constantError.ErrOrderDate.Error():               "tanggal pesanan harus lebih dari hari ini",
+		constantError.ErrStatus.Error():                  "status tidak valid",
+		constantError.ErrTooManyRequest.Error():          "terlalu banyak permintaan, silakan coba lagi nanti",
+		constantError.ErrUnauthorized.Error():            "tidak terautentikasi",
+		constantError.ErrForbidden.Error():               "anda tidak memiliki akses ke sumber daya ini",
+		constantError.ErrDuplicateEvent.Error():          "event sudah diproses",
+		constantError.ErrInvalidCursor.Error():           "cursor tidak valid",
+		constantError.ErrOpenState.Error():               "maaf, layanan pihak ketiga sedang sibuk",
+		constantError.ErrUnknownBreaker.Error():          "circuit breaker tidak terdaftar",
+		constantError.ErrOutboxEvent.Error():             "event outbox tidak dikenal",
+		constantError.ErrParkedMessageNotFound.Error():   "pesan yang diparkir tidak ditemukan",
+		constantError.ErrParkedMessageReplayed.Error():   "pesan yang diparkir sudah diputar ulang",
+		constantError.ErrReplayHandlerNotFound.Error():   "tidak ada handler replay untuk topik ini",
+
+		errOrder.ErrOrderNotFound.Error():         "error: pesanan tidak ditemukan",
+		errOrder.ErrPreviousOrderNotEmpty.Error(): "error: pesanan sebelumnya belum selesai",
+		errOrder.ErrOrderIsEmpty.Error():          "error: id pesanan tidak boleh kosong",
+		errOrder.ErrCancelOrder.Error():           "error: pesanan ini sudah dibatalkan",
+		errOrder.ErrRefundNotAllowed.Error():      "error: hanya pesanan yang sudah dibayar yang dapat dikembalikan dananya",
+		errOrder.ErrInvalidRefundAmount.Error():   "error: jumlah pengembalian dana melebihi jumlah yang dapat dikembalikan",
+		errOrder.ErrRefundNotFound.Error():        "error: pengembalian dana tidak ditemukan",
+		errOrder.ErrPaymentLinkNotExpired.Error(): "error: link pembayaran hanya dapat dibuat ulang untuk cicilan yang kedaluwarsa atau dibatalkan",
+		errOrder.ErrSupersededPaymentPaid.Error(): "error: link pembayaran lama yang sudah diganti telah dibayar",
+		errOrder.ErrUnsupportedCurrency.Error():   "error: mata uang paket tidak didukung",
+
+		errOrder.ErrInstallmentPlanNotFound.Error():  "error: rencana cicilan tidak ditemukan",
+		errOrder.ErrInvalidInstallmentPlan.Error():   "error: rencana cicilan tidak valid",
+		errOrder.ErrInstallmentNotInPlan.Error():     "error: jenis pembayaran tidak termasuk dalam rencana cicilan",
+		errOrder.ErrInstallmentOutOfOrder.Error():    "error: cicilan sebelumnya belum dibayar",
+		errOrder.ErrInstallmentAlreadyPaid.Error():   "error: cicilan ini sudah dibayar",
+		errOrder.ErrInvalidInstallmentAmount.Error(): "error: jumlah tidak sesuai dengan rencana cicilan",
+
+		errOrder.ErrVoucherNotFound.Error():             "error: voucher tidak ditemukan",
+		errOrder.ErrVoucherCodeExists.Error():           "error: kode voucher sudah digunakan",
+		errOrder.ErrInvalidVoucher.Error():              "error: voucher tidak valid",
+		errOrder.ErrVoucherNotActive.Error():            "error: voucher tidak aktif",
+		errOrder.ErrVoucherNotApplicable.Error():        "error: voucher tidak berlaku untuk pesanan ini",
+		errOrder.ErrVoucherUsageLimitReached.Error():    "error: batas penggunaan voucher sudah tercapai",
+		errOrder.ErrVoucherCustomerLimitReached.Error(): "error: voucher sudah digunakan sebanyak batas maksimal oleh pelanggan ini",
+	},
+}
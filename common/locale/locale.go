@@ -0,0 +1,109 @@
+package locale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"order-service/constant"
+)
+
+type Locale string
+
+const (
+	Indonesian Locale = "id"
+	English    Locale = "en"
+
+	Default = Indonesian
+)
+
+type contextKey struct{}
+
+// Parse reads a locale code or an Accept-Language header such as "en-US,en;q=0.9" and returns
+// the first supported language, falling back to the default locale.
+func Parse(value string) Locale {
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(strings.SplitN(tag, ";", 2)[0])
+		language := Locale(strings.ToLower(strings.SplitN(strings.ReplaceAll(tag, "_", "-"), "-", 2)[0]))
+		if language.IsSupported() {
+			return language
+		}
+	}
+	return Default
+}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale requested by the caller or the default locale.
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := Requested(ctx); ok {
+		return locale
+	}
+	return Default
+}
+
+// Requested returns the locale only when the caller asked for one through the request header or
+// the customer profile.
+func Requested(ctx context.Context) (Locale, bool) {
+	locale, ok := ctx.Value(contextKey{}).(Locale)
+	return locale, ok
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func (l Locale) IsSupported() bool {
+	_, ok := catalogs[l]
+	return ok
+}
+
+// Translate returns the message of the key in the locale catalog, a key missing from the catalog
+// falls back to the default locale and then to the key itself.
+func (l Locale) Translate(key string, args ...interface{}) string {
+	message, ok := catalogs[l][key]
+	if !ok {
+		message, ok = catalogs[Default][key]
+	}
+	if !ok {
+		message = key
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+// Error translates the message of a known error, other errors keep their own message.
+func (l Locale) Error(err error) string {
+	if message, ok := errorCatalogs[l][err.Error()]; ok {
+		return message
+	}
+	return err.Error()
+}
+
+// Date formats the date as "02 January 2006" with the month name of the locale.
+func (l Locale) Date(date time.Time) string {
+	return fmt.Sprintf("%s %s %s", date.Format("02"), l.Translate(monthKeys[date.Month()]), date.Format("2006"))
+}
+
+// PaymentTypeTitle returns the title of the payment type in the locale, Indonesian titles are
+// kept separately from the default English ones.
+func (l Locale) PaymentTypeTitle(paymentType constant.PaymentType) string {
+	if l == Indonesian {
+		return paymentType.IndonesianTitle().String()
+	}
+	return paymentType.Title().String()
+}
+
+// Title picks between the English and Indonesian titles configured on installment plan items.
+func (l Locale) Title(title, indonesianTitle string) string {
+	if l == Indonesian && indonesianTitle != "" {
+		return indonesianTitle
+	}
+	return title
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "order_service"
+
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+	ResultFailed  = "failed"
+	ResultRetried = "retried"
+)
+
+var (
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	KafkaMessagesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "messages_total",
+		Help:      "Kafka messages consumed by topic, event name and result.",
+	}, []string{"topic", "event", "result"})
+
+	ClientRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of downstream service calls by client, operation and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"client", "operation", "result"})
+
+	ClientErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "client",
+		Name:      "errors_total",
+		Help:      "Failed downstream service calls by client and operation.",
+	}, []string{"client", "operation"})
+
+	CircuitBreakerTransitionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "circuit_breaker",
+		Name:      "transitions_total",
+		Help:      "Circuit breaker state transitions by breaker name.",
+	}, []string{"name", "from", "to"})
+
+	CircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "circuit_breaker",
+		Name:      "state",
+		Help:      "Current circuit breaker state by name, 0 closed, 1 half-open and 2 open.",
+	}, []string{"name"})
+
+	OrdersCreatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_created_total",
+		Help:      "Orders created by payment type.",
+	}, []string{"payment_type"})
+
+	SettlementsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "settlements_total",
+		Help:      "Settled payments by payment type.",
+	}, []string{"payment_type"})
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveClient records a downstream call, a transport error or a non 2xx status counts as an error.
+func ObserveClient(client, operation string, start time.Time, resp *http.Response, errs []error) {
+	result := ResultSuccess
+	if len(errs) > 0 || resp == nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		result = ResultError
+		ClientErrorsTotal.WithLabelValues(client, operation).Inc()
+	}
+	ClientRequestDuration.WithLabelValues(client, operation, result).Observe(time.Since(start).Seconds())
+}
+
+func ObserveHTTP(method, route string, status int, start time.Time) {
+	HTTPRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,160 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in hundredths of the currency unit. Keeping it as an integer makes the
+// arithmetic exact, it is stored as numeric(15,2) and serialized as a plain JSON number so the
+// payloads exchanged with the other services keep their shape.
+type Money int64
+
+const minorUnit = 100
+
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+func New(units int64) Money {
+	return Money(units * minorUnit)
+}
+
+func NewFromMinor(minor int64) Money {
+	return Money(minor)
+}
+
+// NewFromFloat converts through the shortest decimal representation of the value, so 0.1 is
+// read as 0.1 instead of its binary approximation, and rounds it half away from zero.
+func NewFromFloat(value float64) Money {
+	amount, _ := Parse(strconv.FormatFloat(value, 'f', -1, 64)) //nolint:errcheck
+	return amount
+}
+
+func Parse(value string) (Money, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	return round(rat.Mul(rat, big.NewRat(minorUnit, 1))), nil
+}
+
+func Min(a, b Money) Money {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (m Money) Minor() int64 {
+	return int64(m)
+}
+
+// Units returns the amount rounded half away from zero to whole currency units.
+func (m Money) Units() int64 {
+	return int64(round(big.NewRat(int64(m), minorUnit)))
+}
+
+func (m Money) Float64() float64 {
+	return float64(m) / minorUnit
+}
+
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+// Percent returns rate percent of the amount, rounded half away from zero to the given number of
+// decimals of the currency, 0 for currencies without a minor unit such as IDR and JPY.
+func (m Money) Percent(rate float64, decimals int) Money {
+	percentage, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	if !ok {
+		return 0
+	}
+	percentage.Mul(percentage, new(big.Rat).SetInt64(int64(m)))
+	return roundTo(percentage.Quo(percentage, big.NewRat(100, 1)), decimals)
+}
+
+// MulDiv returns the amount scaled by numerator/denominator, rounded half away from zero to the
+// given number of decimals. It is used to spread a total over parts in proportion to their amounts.
+func (m Money) MulDiv(numerator, denominator Money, decimals int) Money {
+	if denominator == 0 {
+		return 0
+	}
+	ratio := big.NewRat(int64(numerator), int64(denominator))
+	return roundTo(ratio.Mul(ratio, big.NewRat(int64(m), 1)), decimals)
+}
+
+func (m Money) String() string {
+	sign := ""
+	minor := int64(m)
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, minor/minorUnit, minor%minorUnit)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m%minorUnit == 0 {
+		return []byte(strconv.FormatInt(int64(m/minorUnit), 10)), nil
+	}
+	return []byte(strings.TrimRight(m.String(), "0")), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "" || value == "null" {
+		return nil
+	}
+
+	amount, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*m = amount
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src interface{}) error {
+	var err error
+	switch value := src.(type) {
+	case nil:
+		*m = 0
+	case string:
+		*m, err = Parse(value)
+	case []byte:
+		*m, err = Parse(string(value))
+	case int64:
+		*m = New(value)
+	case float64:
+		*m = NewFromFloat(value)
+	default:
+		err = fmt.Errorf("%w: unsupported type %T", ErrInvalidAmount, src)
+	}
+	return err
+}
+
+// roundTo rounds a rational amount of minor units to a multiple of 10^(2-decimals) minor units,
+// decimals outside 0 to 2 are clamped.
+func roundTo(rat *big.Rat, decimals int) Money {
+	step := int64(1)
+	for i := max(decimals, 0); i < 2; i++ {
+		step *= 10
+	}
+	return round(rat.Quo(rat, big.NewRat(step, 1))) * Money(step)
+}
+
+// round rounds the rational half away from zero to an integer amount of minor units.
+func round(rat *big.Rat) Money {
+	quotient, remainder := new(big.Int).QuoRem(rat.Num(), rat.Denom(), new(big.Int))
+	if remainder.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(rat.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(rat.Num().Sign())))
+	}
+	return Money(quotient.Int64())
+}
@@ -0,0 +1,126 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Money
+		wantErr bool
+	}{
+		{name: "whole units", value: "4500000", want: New(4500000)},
+		{name: "two decimals", value: "12.34", want: NewFromMinor(1234)},
+		{name: "rounds half up", value: "0.005", want: NewFromMinor(1)},
+		{name: "rounds negative half away from zero", value: "-0.005", want: NewFromMinor(-1)},
+		{name: "rounds down below half", value: "0.0049", want: 0},
+		{name: "trims spaces", value: " 1.5 ", want: NewFromMinor(150)},
+		{name: "invalid", value: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Money
+		rate     float64
+		decimals int
+		want     Money
+	}{
+		{name: "exact", amount: New(4500000), rate: 30, decimals: 0, want: New(1350000)},
+		{name: "no minor unit rounds down", amount: New(4500001), rate: 10, decimals: 0, want: New(450000)},
+		{name: "no minor unit rounds half up", amount: New(4500005), rate: 10, decimals: 0, want: New(450001)},
+		{name: "no minor unit fractional rate", amount: New(1000), rate: 33.33, decimals: 0, want: New(333)},
+		{name: "minor unit keeps cents", amount: New(1000), rate: 33.33, decimals: 2, want: NewFromMinor(33330)},
+		{name: "minor unit rounds half up", amount: NewFromMinor(105), rate: 50, decimals: 2, want: NewFromMinor(53)},
+		{name: "one decimal", amount: NewFromMinor(1234), rate: 50, decimals: 1, want: NewFromMinor(620)},
+		{name: "negative rounds away from zero", amount: New(-5), rate: 10, decimals: 0, want: New(-1)},
+		{name: "zero rate", amount: New(100), rate: 0, decimals: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.amount.Percent(tt.rate, tt.decimals)
+			if got != tt.want {
+				t.Errorf("%s.Percent(%v, %d) = %s, want %s", tt.amount, tt.rate, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      Money
+		numerator   Money
+		denominator Money
+		decimals    int
+		want        Money
+	}{
+		{name: "exact share", amount: New(100000), numerator: New(1), denominator: New(4), decimals: 0, want: New(25000)},
+		{name: "no minor unit rounds down", amount: New(100000), numerator: New(1), denominator: New(3), decimals: 0, want: New(33333)},
+		{name: "no minor unit rounds up", amount: New(100000), numerator: New(2), denominator: New(3), decimals: 0, want: New(66667)},
+		{name: "minor unit keeps cents", amount: New(100), numerator: New(1), denominator: New(3), decimals: 2, want: NewFromMinor(3333)},
+		{name: "negative rounds away from zero", amount: New(-100000), numerator: New(2), denominator: New(3), decimals: 0, want: New(-66667)},
+		{name: "zero denominator", amount: New(100), numerator: New(1), denominator: 0, decimals: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.amount.MulDiv(tt.numerator, tt.denominator, tt.decimals)
+			if got != tt.want {
+				t.Errorf("%s.MulDiv(%s, %s, %d) = %s, want %s",
+					tt.amount, tt.numerator, tt.denominator, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Money
+		want   string
+	}{
+		{name: "whole units", amount: New(4500000), want: "4500000"},
+		{name: "cents", amount: NewFromMinor(1234), want: "12.34"},
+		{name: "trailing zero", amount: NewFromMinor(150), want: "1.5"},
+		{name: "negative", amount: NewFromMinor(-1234), want: "-12.34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.amount)
+			if err != nil {
+				t.Fatalf("json.Marshal(%s) error = %v", tt.amount, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%s) = %s, want %s", tt.amount, got, tt.want)
+			}
+
+			var decoded Money
+			err = json.Unmarshal(got, &decoded)
+			if err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", got, err)
+			}
+			if decoded != tt.amount {
+				t.Errorf("json.Unmarshal(%s) = %s, want %s", got, decoded, tt.amount)
+			}
+		})
+	}
+}
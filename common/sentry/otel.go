@@ -0,0 +1,166 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry exports spans over OTLP/HTTP, exceptions are still reported to sentry when a dsn
+// is configured.
+type OpenTelemetry struct {
+	endpoint    string
+	insecure    bool
+	serviceName string
+	env         string
+	sampleRate  float64
+	dsn         string
+	provider    *sdktrace.TracerProvider
+	tracer      trace.Tracer
+}
+
+type TelemetryOption func(*OpenTelemetry)
+
+type otelSpan struct {
+	ctx  context.Context
+	span trace.Span
+}
+
+func (s *otelSpan) Context() context.Context {
+	return s.ctx
+}
+
+func (s *otelSpan) Finish() {
+	s.span.End()
+}
+
+func WithOtlpEndpoint(endpoint string) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		o.endpoint = endpoint
+	}
+}
+
+func WithOtlpInsecure(insecure bool) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		o.insecure = insecure
+	}
+}
+
+func WithServiceName(serviceName string) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		o.serviceName = serviceName
+	}
+}
+
+func WithTelemetryEnv(env string) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		o.env = env
+	}
+}
+
+func WithTelemetrySampleRate(sampleRate float64) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		if sampleRate > 0 {
+			o.sampleRate = sampleRate
+		}
+	}
+}
+
+func WithErrorDsn(dsn string) TelemetryOption {
+	return func(o *OpenTelemetry) {
+		o.dsn = dsn
+	}
+}
+
+func NewOpenTelemetry(options ...TelemetryOption) ISentry {
+	telemetry := &OpenTelemetry{
+		sampleRate: 1,
+	}
+	for _, option := range options {
+		option(telemetry)
+	}
+
+	exporterOptions := []otlptracehttp.Option{}
+	if telemetry.endpoint != "" {
+		exporterOptions = append(exporterOptions, otlptracehttp.WithEndpoint(telemetry.endpoint))
+	}
+	if telemetry.insecure {
+		exporterOptions = append(exporterOptions, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), exporterOptions...)
+	if err != nil {
+		panic(err)
+	}
+
+	telemetry.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(telemetry.sampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", telemetry.serviceName),
+			attribute.String("deployment.environment", telemetry.env),
+		)),
+	)
+	otel.SetTracerProvider(telemetry.provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	telemetry.tracer = telemetry.provider.Tracer(telemetry.serviceName)
+
+	if telemetry.dsn != "" {
+		err = sentry.Init(sentry.ClientOptions{
+			Dsn:         telemetry.dsn,
+			Environment: telemetry.env,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return telemetry
+}
+
+func (o *OpenTelemetry) StartSpan(ctx context.Context, spanName string) Span {
+	ctx, span := o.tracer.Start(ctx, spanName)
+	return &otelSpan{
+		ctx:  ctx,
+		span: span,
+	}
+}
+
+func (o *OpenTelemetry) Finish(span Span) {
+	span.Finish()
+}
+
+func (o *OpenTelemetry) CaptureException(exception error) *sentry.EventID {
+	return sentry.CaptureException(exception)
+}
+
+func (o *OpenTelemetry) SpanContext(span Span) context.Context {
+	return span.Context()
+}
+
+func (o *OpenTelemetry) Inject(ctx context.Context) map[string]string {
+	headers := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, headers)
+	return headers
+}
+
+func (o *OpenTelemetry) Extract(ctx context.Context, headers map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(headers))
+}
+
+func (o *OpenTelemetry) Flush(ctx context.Context) error {
+	if o.dsn != "" {
+		sentry.Flush(flushTimeout)
+	}
+	return o.provider.Shutdown(ctx)
+}
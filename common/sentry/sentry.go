@@ -2,6 +2,10 @@ package sentry
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 )
@@ -17,6 +21,15 @@ type SentryPackage struct {
 
 type Option func(*SentryPackage)
 
+const (
+	TraceParentHeader = "traceparent"
+	flushTimeout      = 2 * time.Second
+)
+
+var errFlushTimeout = errors.New("sentry flush timed out")
+
+type remoteTraceKey struct{}
+
 func WithDsn(dsn string) Option {
 	return func(s *SentryPackage) {
 		s.Dsn = dsn
@@ -47,11 +60,20 @@ func WithEnableTracing(enableTracing bool) Option {
 	}
 }
 
+// Span is implemented by *sentry.Span and by the OpenTelemetry span wrapper.
+type Span interface {
+	Context() context.Context
+	Finish()
+}
+
 type ISentry interface {
-	StartSpan(ctx context.Context, spanName string) *sentry.Span
-	Finish(span *sentry.Span)
+	StartSpan(ctx context.Context, spanName string) Span
+	Finish(span Span)
 	CaptureException(exception error) *sentry.EventID
-	SpanContext(span *sentry.Span) context.Context
+	SpanContext(span Span) context.Context
+	Inject(ctx context.Context) map[string]string
+	Extract(ctx context.Context, headers map[string]string) context.Context
+	Flush(ctx context.Context) error
 }
 
 func NewSentry(options ...Option) ISentry {
@@ -76,11 +98,14 @@ func NewSentry(options ...Option) ISentry {
 	return sentryPkg
 }
 
-func (s *SentryPackage) StartSpan(ctx context.Context, spanName string) *sentry.Span {
+func (s *SentryPackage) StartSpan(ctx context.Context, spanName string) Span {
+	if trace, ok := ctx.Value(remoteTraceKey{}).(string); ok && sentry.SpanFromContext(ctx) == nil {
+		return sentry.StartSpan(ctx, spanName, sentry.ContinueFromTrace(trace))
+	}
 	return sentry.StartSpan(ctx, spanName)
 }
 
-func (s *SentryPackage) Finish(span *sentry.Span) {
+func (s *SentryPackage) Finish(span Span) {
 	span.Finish()
 }
 
@@ -88,6 +113,48 @@ func (s *SentryPackage) CaptureException(exception error) *sentry.EventID {
 	return sentry.CaptureException(exception)
 }
 
-func (s *SentryPackage) SpanContext(span *sentry.Span) context.Context {
+func (s *SentryPackage) SpanContext(span Span) context.Context {
 	return span.Context()
 }
+
+// Inject writes the active span as a W3C traceparent header.
+func (s *SentryPackage) Inject(ctx context.Context) map[string]string {
+	span := sentry.SpanFromContext(ctx)
+	if span == nil {
+		return map[string]string{}
+	}
+
+	flags := "00"
+	if span.Sampled.Bool() {
+		flags = "01"
+	}
+	return map[string]string{
+		TraceParentHeader: fmt.Sprintf("00-%s-%s-%s", span.TraceID, span.SpanID, flags),
+	}
+}
+
+// Extract keeps the remote parent from a W3C traceparent header in the context, the next root
+// span started from it continues that trace.
+func (s *SentryPackage) Extract(ctx context.Context, headers map[string]string) context.Context {
+	parts := strings.Split(headers[TraceParentHeader], "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return ctx
+	}
+
+	sampled := "0"
+	if parts[3] == "01" {
+		sampled = "1"
+	}
+	return context.WithValue(ctx, remoteTraceKey{}, fmt.Sprintf("%s-%s-%s", parts[1], parts[2], sampled))
+}
+
+func (s *SentryPackage) Flush(ctx context.Context) error {
+	timeout := flushTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	if !sentry.Flush(timeout) {
+		return errFlushTimeout
+	}
+	return nil
+}
@@ -11,8 +11,12 @@ var mapProcessFlow = map[string]fsm.Events{
 	status: {
 		{
 			Name: constant.Pending.String(),
-			Src:  []string{constant.Initial.String()},
-			Dst:  constant.Pending.String(),
+			Src: []string{
+				constant.Initial.String(),
+				constant.PendingPayment.String(),
+				constant.Cancelled.String(),
+			},
+			Dst: constant.Pending.String(),
 		},
 		{
 			Name: constant.PendingPayment.String(),
@@ -32,6 +36,22 @@ var mapProcessFlow = map[string]fsm.Events{
 			},
 			Dst: constant.Cancelled.String(),
 		},
+		{
+			Name: constant.PartiallyRefunded.String(),
+			Src: []string{
+				constant.PaymentSuccess.String(),
+				constant.PartiallyRefunded.String(),
+			},
+			Dst: constant.PartiallyRefunded.String(),
+		},
+		{
+			Name: constant.Refunded.String(),
+			Src: []string{
+				constant.PaymentSuccess.String(),
+				constant.PartiallyRefunded.String(),
+			},
+			Dst: constant.Refunded.String(),
+		},
 	},
 }
 
@@ -11,33 +11,110 @@ import (
 
 var Config AppConfig
 
+// Source tells where the configuration was loaded from, either the local file or consul.
+var Source string
+
+const (
+	SourceFile   = "file"
+	SourceConsul = "consul"
+)
+
+const (
+	TracingSentry = "sentry"
+	TracingOtel   = "otel"
+)
+
 type AppConfig struct {
-	Port                               int             `json:"port" yaml:"port"`
-	AppName                            string          `json:"appName" yaml:"appName"`
-	AppEnv                             string          `json:"appEnv" yaml:"appEnv"`
-	AppDebug                           bool            `json:"appDebug" yaml:"appDebug"`
-	SignatureKey                       string          `json:"signatureKey" yaml:"signatureKey"`
-	Database                           Database        `json:"database" yaml:"database"`
-	InternalService                    InternalService `json:"internalService" yaml:"internalService"`
-	KafkaHosts                         []string        `json:"kafkaHosts" yaml:"kafkaHosts"`
-	KafkaTimeoutInMs                   int             `json:"kafkaTimeoutInMs" yaml:"kafkaTimeoutInMs"`
-	KafkaMaxRetry                      int             `json:"kafkaMaxRetry" yaml:"kafkaMaxRetry"`
-	KafkaProducerTopic                 string          `json:"kafkaProducerTopic" yaml:"kafkaProducerTopic"`
-	KafkaConsumerFetchDefault          int32           `json:"kafkaConsumerFetchDefault" yaml:"kafkaConsumerFetchDefault"`
-	KafkaConsumerFetchMin              int32           `json:"kafkaConsumerFetchMin" yaml:"kafkaConsumerFetchMin"`
-	KafkaConsumerFetchMax              int32           `json:"kafkaConsumerFetchMax" yaml:"kafkaConsumerFetchMax"`
-	KafkaConsumerMaxWaitTimeInMs       int32           `json:"kafkaConsumerMaxWaitTimeInMs" yaml:"kafkaConsumerMaxWaitTimeInMs"`     //nolint:lll
-	KafkaConsumerMaxProcessingTimeInMs int32           `json:"kafkaConsumerMaxProcessingTimeInMs" yaml:"kafkaConsumerMaxProcTimeMs"` //nolint:lll
-	KafkaConsumerBackoffTimeInMs       int32           `json:"kafkaConsumerBackoffTimeInMs" yaml:"kafkaConsumerBackoffTimeMs"`       //nolint:lll
-	KafkaConsumerTopics                []string        `json:"kafkaConsumerStatusTopics" yaml:"kafkaConsumerTopics"`
-	KafkaConsumerGroupID               string          `json:"kafkaConsumerGroupID" yaml:"kafkaConsumerGroupID"`
-	SentryDsn                          string          `json:"sentryDsn" yaml:"sentryDsn"`
-	SentrySampleRate                   float64         `json:"sentrySampleRate" yaml:"sentrySampleRate"`
-	SentryEnableTracing                bool            `json:"SentryEnableTracing" yaml:"SentryEnableTracing"`
-	CircuitBreakerMaxRequest           uint32          `json:"circuitBreakerMaxRequest" yaml:"circuitBreakerMaxRequest"`
-	CircuitBreakerTimeoutInSecond      uint32          `json:"circuitBreakerTimeoutInSecond" yaml:"circuitBreakerTimeoutInSecond"` //nolint:lll
-	RateLimiterMaxRequest              float64         `json:"rateLimiterMaxRequest" yaml:"rateLimiterMaxRequest"`
-	RateLimiterTimeSecond              int             `json:"rateLimiterTimeSecond" yaml:"rateLimiterTimeSecond"`
+	Port                               int                         `json:"port" yaml:"port"`
+	HTTPServer                         HTTPServer                  `json:"httpServer" yaml:"httpServer"`
+	AppName                            string                      `json:"appName" yaml:"appName"`
+	AppEnv                             string                      `json:"appEnv" yaml:"appEnv"`
+	AppDebug                           bool                        `json:"appDebug" yaml:"appDebug"`
+	SignatureKey                       string                      `json:"signatureKey" yaml:"signatureKey"`
+	Database                           Database                    `json:"database" yaml:"database"`
+	InternalService                    InternalService             `json:"internalService" yaml:"internalService"`
+	KafkaHosts                         []string                    `json:"kafkaHosts" yaml:"kafkaHosts"`
+	KafkaTimeoutInMs                   int                         `json:"kafkaTimeoutInMs" yaml:"kafkaTimeoutInMs"`
+	KafkaMaxRetry                      int                         `json:"kafkaMaxRetry" yaml:"kafkaMaxRetry"`
+	KafkaProducerTopic                 string                      `json:"kafkaProducerTopic" yaml:"kafkaProducerTopic"`
+	KafkaDeadLetterTopic               string                      `json:"kafkaDeadLetterTopic" yaml:"kafkaDeadLetterTopic"`
+	KafkaDedupWindowInHour             int                         `json:"kafkaDedupWindowInHour" yaml:"kafkaDedupWindowInHour"`
+	KafkaRetryPolicies                 map[string]KafkaRetryPolicy `json:"kafkaRetryPolicies" yaml:"kafkaRetryPolicies"`
+	KafkaConsumerFetchDefault          int32                       `json:"kafkaConsumerFetchDefault" yaml:"kafkaConsumerFetchDefault"`
+	KafkaConsumerFetchMin              int32                       `json:"kafkaConsumerFetchMin" yaml:"kafkaConsumerFetchMin"`
+	KafkaConsumerFetchMax              int32                       `json:"kafkaConsumerFetchMax" yaml:"kafkaConsumerFetchMax"`
+	KafkaConsumerMaxWaitTimeInMs       int32                       `json:"kafkaConsumerMaxWaitTimeInMs" yaml:"kafkaConsumerMaxWaitTimeInMs"`     //nolint:lll
+	KafkaConsumerMaxProcessingTimeInMs int32                       `json:"kafkaConsumerMaxProcessingTimeInMs" yaml:"kafkaConsumerMaxProcTimeMs"` //nolint:lll
+	KafkaConsumerBackoffTimeInMs       int32                       `json:"kafkaConsumerBackoffTimeInMs" yaml:"kafkaConsumerBackoffTimeMs"`       //nolint:lll
+	KafkaConsumerTopics                []string                    `json:"kafkaConsumerStatusTopics" yaml:"kafkaConsumerTopics"`
+	KafkaConsumerGroupID               string                      `json:"kafkaConsumerGroupID" yaml:"kafkaConsumerGroupID"`
+	SentryDsn                          string                      `json:"sentryDsn" yaml:"sentryDsn"`
+	SentrySampleRate                   float64                     `json:"sentrySampleRate" yaml:"sentrySampleRate"`
+	SentryEnableTracing                bool                        `json:"SentryEnableTracing" yaml:"SentryEnableTracing"`
+	Tracing                            Tracing                     `json:"tracing" yaml:"tracing"`
+	CircuitBreakerMaxRequest           uint32                      `json:"circuitBreakerMaxRequest" yaml:"circuitBreakerMaxRequest"`
+	CircuitBreakerTimeoutInSecond      uint32                      `json:"circuitBreakerTimeoutInSecond" yaml:"circuitBreakerTimeoutInSecond"` //nolint:lll
+	CircuitBreakers                    map[string]CircuitBreaker   `json:"circuitBreakers" yaml:"circuitBreakers"`
+	RateLimiterMaxRequest              float64                     `json:"rateLimiterMaxRequest" yaml:"rateLimiterMaxRequest"`
+	RateLimiterTimeSecond              int                         `json:"rateLimiterTimeSecond" yaml:"rateLimiterTimeSecond"`
+	Outbox                             Outbox                      `json:"outbox" yaml:"outbox"`
+	ExpirySweeper                      ExpirySweeper               `json:"expirySweeper" yaml:"expirySweeper"`
+	PaymentReminder                    PaymentReminder             `json:"paymentReminder" yaml:"paymentReminder"`
+}
+
+type HTTPServer struct {
+	ReadTimeoutInSecond     int `json:"readTimeoutInSecond" yaml:"readTimeoutInSecond"`
+	WriteTimeoutInSecond    int `json:"writeTimeoutInSecond" yaml:"writeTimeoutInSecond"`
+	IdleTimeoutInSecond     int `json:"idleTimeoutInSecond" yaml:"idleTimeoutInSecond"`
+	ShutdownTimeoutInSecond int `json:"shutdownTimeoutInSecond" yaml:"shutdownTimeoutInSecond"`
+}
+
+// Tracing selects where spans are exported, sentry keeps the previous behaviour and otel sends
+// them to an OTLP/HTTP collector.
+type Tracing struct {
+	Provider     string  `json:"provider" yaml:"provider"`
+	OtlpEndpoint string  `json:"otlpEndpoint" yaml:"otlpEndpoint"`
+	OtlpInsecure bool    `json:"otlpInsecure" yaml:"otlpInsecure"`
+	SampleRate   float64 `json:"sampleRate" yaml:"sampleRate"`
+}
+
+// CircuitBreaker overrides the default breaker settings of a downstream service, keyed by the
+// service name. A trip ratio opens the breaker once the share of failed requests reaches it.
+type CircuitBreaker struct {
+	MaxRequest       uint32  `json:"maxRequest" yaml:"maxRequest"`
+	IntervalInSecond uint32  `json:"intervalInSecond" yaml:"intervalInSecond"`
+	TimeoutInSecond  uint32  `json:"timeoutInSecond" yaml:"timeoutInSecond"`
+	TripRatio        float64 `json:"tripRatio" yaml:"tripRatio"`
+	MinRequest       uint32  `json:"minRequest" yaml:"minRequest"`
+}
+
+type KafkaRetryPolicy struct {
+	MaxAttempt         int     `json:"maxAttempt" yaml:"maxAttempt"`
+	InitialBackoffInMs int     `json:"initialBackoffInMs" yaml:"initialBackoffInMs"`
+	MaxBackoffInMs     int     `json:"maxBackoffInMs" yaml:"maxBackoffInMs"`
+	Multiplier         float64 `json:"multiplier" yaml:"multiplier"`
+	Jitter             float64 `json:"jitter" yaml:"jitter"`
+}
+
+type Outbox struct {
+	DispatchIntervalInSecond int `json:"dispatchIntervalInSecond" yaml:"dispatchIntervalInSecond"`
+	BatchSize                int `json:"batchSize" yaml:"batchSize"`
+	MaxAttempt               int `json:"maxAttempt" yaml:"maxAttempt"`
+	BackoffInSecond          int `json:"backoffInSecond" yaml:"backoffInSecond"`
+	MaxBackoffInSecond       int `json:"maxBackoffInSecond" yaml:"maxBackoffInSecond"`
+	LockTimeoutInSecond      int `json:"lockTimeoutInSecond" yaml:"lockTimeoutInSecond"`
+}
+
+type ExpirySweeper struct {
+	IntervalInSecond    int `json:"intervalInSecond" yaml:"intervalInSecond"`
+	BatchSize           int `json:"batchSize" yaml:"batchSize"`
+	GracePeriodInSecond int `json:"gracePeriodInSecond" yaml:"gracePeriodInSecond"`
+}
+
+type PaymentReminder struct {
+	IntervalInSecond int   `json:"intervalInSecond" yaml:"intervalInSecond"`
+	BatchSize        int   `json:"batchSize" yaml:"batchSize"`
+	OffsetsInMinute  []int `json:"offsetsInMinute" yaml:"offsetsInMinute"`
 }
 
 type Database struct {
@@ -62,10 +139,11 @@ type InternalService struct {
 }
 
 type Invoice struct {
-	Host       string `json:"host" yaml:"host"`
-	SecretKey  string `json:"secretKey" yaml:"secretKey"`
-	TemplateID string `json:"templateID" yaml:"templateID"`
-	StaticKey  string `json:"staticKey" yaml:"staticKey"`
+	Host                 string `json:"host" yaml:"host"`
+	SecretKey            string `json:"secretKey" yaml:"secretKey"`
+	TemplateID           string `json:"templateID" yaml:"templateID"`
+	CreditNoteTemplateID string `json:"creditNoteTemplateID" yaml:"creditNoteTemplateID"`
+	StaticKey            string `json:"staticKey" yaml:"staticKey"`
 }
 
 type Notification struct {
@@ -96,8 +174,10 @@ type Package struct {
 }
 
 func Init() {
+	Source = SourceFile
 	err := helper.BindFromJSON(&Config, "config.json", ".")
 	if err != nil {
+		Source = SourceConsul
 		log.Printf("failed load cold config from file: %s", viper.ConfigFileUsed())
 		err = helper.BindFromConsul(&Config, os.Getenv("CONSUL_HTTP_URL"), os.Getenv("CONSUL_HTTP_KEY"))
 		if err != nil {
@@ -0,0 +1,53 @@
+package constant
+
+type Currency string
+
+const (
+	CurrencyIDR Currency = "IDR"
+	CurrencyUSD Currency = "USD"
+	CurrencySGD Currency = "SGD"
+	CurrencyAUD Currency = "AUD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyJPY Currency = "JPY"
+
+	DefaultCurrency = CurrencyIDR
+)
+
+type CurrencyFormat struct {
+	Decimals  int
+	Thousand  string
+	Separator string
+}
+
+var mapCurrencyToFormat = map[Currency]CurrencyFormat{
+	CurrencyIDR: {Decimals: 0, Thousand: ".", Separator: ","},
+	CurrencyUSD: {Decimals: 2, Thousand: ",", Separator: "."},
+	CurrencySGD: {Decimals: 2, Thousand: ",", Separator: "."},
+	CurrencyAUD: {Decimals: 2, Thousand: ",", Separator: "."},
+	CurrencyEUR: {Decimals: 2, Thousand: ".", Separator: ","},
+	CurrencyJPY: {Decimals: 0, Thousand: ",", Separator: "."},
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+func (c Currency) IsSupported() bool {
+	_, ok := mapCurrencyToFormat[c]
+	return ok
+}
+
+// Format returns how amounts in the currency are printed on invoices and notifications, an
+// unknown currency falls back to the rupiah format.
+func (c Currency) Format() CurrencyFormat {
+	if format, ok := mapCurrencyToFormat[c]; ok {
+		return format
+	}
+	return mapCurrencyToFormat[DefaultCurrency]
+}
+
+// Decimals is the number of decimals of the minor unit, amounts computed from a percentage are
+// rounded to it.
+func (c Currency) Decimals() int {
+	return c.Format().Decimals
+}
@@ -5,9 +5,11 @@ import (
 )
 
 var (
-	ErrOpenState = errors.New("sorry, third party service is busy")
+	ErrOpenState      = errors.New("sorry, third party service is busy")
+	ErrUnknownBreaker = errors.New("circuit breaker is not registered")
 )
 
 var CircuitBreakerErrors = []error{
 	ErrOpenState,
+	ErrUnknownBreaker,
 }
@@ -7,6 +7,8 @@ import (
 func ErrorMapping(err error) bool {
 	allErrors := make([]error, 0)
 	allErrors = append(append(GeneralErrors[:], CircuitBreakerErrors[:]...), order.OrderErrors[:]...)
+	allErrors = append(allErrors, OutboxErrors[:]...)
+	allErrors = append(allErrors, ParkedMessageErrors[:]...)
 
 	for _, knownError := range allErrors {
 		if err.Error() == knownError.Error() {
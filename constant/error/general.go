@@ -15,6 +15,8 @@ var (
 	ErrTooManyRequest          = errors.New("too many request, please try again later")
 	ErrUnauthorized            = errors.New("unauthorized")
 	ErrForbidden               = errors.New("you don't have permission to access this resource")
+	ErrDuplicateEvent          = errors.New("event already processed")
+	ErrInvalidCursor           = errors.New("invalid cursor")
 )
 
 var GeneralErrors = []error{
@@ -25,4 +27,6 @@ var GeneralErrors = []error{
 	ErrTooManyRequest,
 	ErrUnauthorized,
 	ErrForbidden,
+	ErrDuplicateEvent,
+	ErrInvalidCursor,
 }
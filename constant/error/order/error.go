@@ -7,12 +7,27 @@ var (
 	ErrPreviousOrderNotEmpty = errors.New(`error: previous order not completed yet`)
 	ErrOrderIsEmpty          = errors.New(`error: order id cannot be empty`)
 	ErrCancelOrder           = errors.New(`error: this order already cancelled`)
-	ErrInvalidHalfAmount     = errors.New(
-		`error: amount must be 50% from (remaining outstanding amount - down payment)`)
-	ErrInvalidFullAmount = errors.New(
-		`error: amount must be 100% from (remaining outstanding amount - half payment)`)
-	ErrFullPaymentNotEmpty = errors.New(`error: your bill for 100% has been paid`)
-	ErrHalfPaymentNotEmpty = errors.New(`error: your bill for 50% has been paid`)
+	ErrRefundNotAllowed      = errors.New(`error: only paid order can be refunded`)
+	ErrInvalidRefundAmount   = errors.New(`error: refund amount exceeds the refundable amount`)
+	ErrRefundNotFound        = errors.New(`error: refund not found`)
+	ErrPaymentLinkNotExpired = errors.New(`error: payment link can only be regenerated for an expired or cancelled installment`)
+	ErrUnsupportedCurrency   = errors.New(`error: package currency is not supported`)
+	ErrSupersededPaymentPaid = errors.New(`error: a superseded payment link was paid`)
+
+	ErrInstallmentPlanNotFound  = errors.New(`error: installment plan not found`)
+	ErrInvalidInstallmentPlan   = errors.New(`error: invalid installment plan`)
+	ErrInstallmentNotInPlan     = errors.New(`error: payment type is not part of the installment plan`)
+	ErrInstallmentOutOfOrder    = errors.New(`error: previous installment has not been paid yet`)
+	ErrInstallmentAlreadyPaid   = errors.New(`error: this installment has been paid`)
+	ErrInvalidInstallmentAmount = errors.New(`error: amount does not match the installment plan`)
+
+	ErrVoucherNotFound             = errors.New(`error: voucher not found`)
+	ErrVoucherCodeExists           = errors.New(`error: voucher code already exists`)
+	ErrInvalidVoucher              = errors.New(`error: invalid voucher`)
+	ErrVoucherNotActive            = errors.New(`error: voucher is not active`)
+	ErrVoucherNotApplicable        = errors.New(`error: voucher is not applicable to this order`)
+	ErrVoucherUsageLimitReached    = errors.New(`error: voucher usage limit has been reached`)
+	ErrVoucherCustomerLimitReached = errors.New(`error: voucher has been used the maximum number of times by this customer`)
 )
 
 var OrderErrors = []error{
@@ -20,8 +35,23 @@ var OrderErrors = []error{
 	ErrPreviousOrderNotEmpty,
 	ErrOrderIsEmpty,
 	ErrCancelOrder,
-	ErrInvalidHalfAmount,
-	ErrInvalidFullAmount,
-	ErrFullPaymentNotEmpty,
-	ErrHalfPaymentNotEmpty,
+	ErrRefundNotAllowed,
+	ErrInvalidRefundAmount,
+	ErrRefundNotFound,
+	ErrPaymentLinkNotExpired,
+	ErrUnsupportedCurrency,
+	ErrSupersededPaymentPaid,
+	ErrInstallmentPlanNotFound,
+	ErrInvalidInstallmentPlan,
+	ErrInstallmentNotInPlan,
+	ErrInstallmentOutOfOrder,
+	ErrInstallmentAlreadyPaid,
+	ErrInvalidInstallmentAmount,
+	ErrVoucherNotFound,
+	ErrVoucherCodeExists,
+	ErrInvalidVoucher,
+	ErrVoucherNotActive,
+	ErrVoucherNotApplicable,
+	ErrVoucherUsageLimitReached,
+	ErrVoucherCustomerLimitReached,
 }
@@ -0,0 +1,13 @@
+package error
+
+import (
+	"errors"
+)
+
+var (
+	ErrOutboxEvent = errors.New("unknown outbox event")
+)
+
+var OutboxErrors = []error{
+	ErrOutboxEvent,
+}
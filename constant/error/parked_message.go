@@ -0,0 +1,17 @@
+package error
+
+import (
+	"errors"
+)
+
+var (
+	ErrParkedMessageNotFound = errors.New("parked message not found")
+	ErrParkedMessageReplayed = errors.New("parked message already replayed")
+	ErrReplayHandlerNotFound = errors.New("no replay handler registered for this topic")
+)
+
+var ParkedMessageErrors = []error{
+	ErrParkedMessageNotFound,
+	ErrParkedMessageReplayed,
+	ErrReplayHandlerNotFound,
+}
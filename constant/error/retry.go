@@ -0,0 +1,31 @@
+package error
+
+import (
+	"errors"
+
+	"order-service/constant/error/order"
+)
+
+var NonRetryableErrors = []error{
+	ErrInvalidStatusTransition,
+	ErrOrderDate,
+	ErrStatus,
+	ErrUnauthorized,
+	ErrForbidden,
+	ErrDuplicateEvent,
+	ErrOutboxEvent,
+	ErrParkedMessageNotFound,
+	ErrParkedMessageReplayed,
+	ErrReplayHandlerNotFound,
+	ErrUnknownBreaker,
+}
+
+func IsRetryable(err error) bool {
+	nonRetryableErrors := append(NonRetryableErrors[:], order.OrderErrors[:]...)
+	for _, nonRetryableError := range nonRetryableErrors {
+		if errors.Is(err, nonRetryableError) {
+			return false
+		}
+	}
+	return true
+}
@@ -3,9 +3,11 @@ package constant
 import "net/textproto"
 
 var (
-	XServiceName  = textproto.CanonicalMIMEHeaderKey("x-service-name")
-	XApiKey       = textproto.CanonicalMIMEHeaderKey("x-api-key")
-	XRequestAt    = textproto.CanonicalMIMEHeaderKey("x-request-at")
-	XRequestID    = textproto.CanonicalMIMEHeaderKey("x-request-id")
-	Authorization = textproto.CanonicalMIMEHeaderKey("authorization")
+	XServiceName    = textproto.CanonicalMIMEHeaderKey("x-service-name")
+	XApiKey         = textproto.CanonicalMIMEHeaderKey("x-api-key")
+	XRequestAt      = textproto.CanonicalMIMEHeaderKey("x-request-at")
+	XRequestID      = textproto.CanonicalMIMEHeaderKey("x-request-id")
+	XIdempotencyKey = textproto.CanonicalMIMEHeaderKey("x-idempotency-key")
+	Authorization   = textproto.CanonicalMIMEHeaderKey("authorization")
+	AcceptLanguage  = textproto.CanonicalMIMEHeaderKey("accept-language")
 )
@@ -0,0 +1,17 @@
+package constant
+
+type InstallmentAmountType string
+
+const (
+	InstallmentPercentage          InstallmentAmountType = "percentage"
+	InstallmentFixed               InstallmentAmountType = "fixed"
+	InstallmentRemainingPercentage InstallmentAmountType = "remaining_percentage"
+	InstallmentRemaining           InstallmentAmountType = "remaining"
+	InstallmentPackageDownPayment  InstallmentAmountType = "package_down_payment"
+
+	DefaultInstallmentPlanName = "default"
+)
+
+func (t InstallmentAmountType) String() string {
+	return string(t)
+}
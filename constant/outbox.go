@@ -0,0 +1,27 @@
+package constant
+
+type OutboxStatus string
+type OutboxEvent string
+
+const (
+	OutboxPending    OutboxStatus = "pending"
+	OutboxProcessing OutboxStatus = "processing"
+	OutboxSucceeded  OutboxStatus = "succeeded"
+	OutboxFailed     OutboxStatus = "failed"
+
+	OutboxCreatePaymentLink OutboxEvent = "create_payment_link"
+	OutboxExpirePaymentLink OutboxEvent = "expire_payment_link"
+	OutboxGenerateInvoice   OutboxEvent = "generate_invoice"
+	OutboxSendWhatsapp      OutboxEvent = "send_whatsapp"
+	OutboxPublishEvent      OutboxEvent = "publish_event"
+	OutboxRefundPayment     OutboxEvent = "refund_payment"
+	OutboxCreditNote        OutboxEvent = "generate_credit_note"
+)
+
+func (o OutboxStatus) String() string {
+	return string(o)
+}
+
+func (o OutboxEvent) String() string {
+	return string(o)
+}
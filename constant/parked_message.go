@@ -0,0 +1,20 @@
+package constant
+
+type ParkedMessageStatus string
+
+const (
+	ParkedMessageParked   ParkedMessageStatus = "parked"
+	ParkedMessageReplayed ParkedMessageStatus = "replayed"
+
+	DeadLetterOriginalTopic     = "x-dlq-original-topic"
+	DeadLetterOriginalPartition = "x-dlq-original-partition"
+	DeadLetterOriginalOffset    = "x-dlq-original-offset"
+	DeadLetterOriginalTimestamp = "x-dlq-original-timestamp"
+	DeadLetterError             = "x-dlq-error"
+	DeadLetterRetryCount        = "x-dlq-retry-count"
+	DeadLetterFailedAt          = "x-dlq-failed-at"
+)
+
+func (p ParkedMessageStatus) String() string {
+	return string(p)
+}
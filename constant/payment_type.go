@@ -1,5 +1,7 @@
 package constant
 
+import "strings"
+
 type PaymentType string
 type PaymentTypeTitle string
 type PaymentTypeIndonesianTitle string
@@ -27,6 +29,12 @@ var mapPaymentTypeToTitle = map[PaymentType]PaymentTypeTitle{
 	PTFullPayment: PTFullPaymentTitle,
 }
 
+var mapPaymentTypeToIndonesianTitle = map[PaymentType]PaymentTypeIndonesianTitle{
+	PTDownPayment: PTDownPaymentIndonesianTitle,
+	PTHalfPayment: PTHalfPaymentIndonesianTitle,
+	PTFullPayment: PTFullPaymentIndonesianTitle,
+}
+
 func (pt PaymentType) String() string {
 	return string(pt)
 }
@@ -40,5 +48,25 @@ func (pt PaymentTypeTitle) String() string {
 }
 
 func (pt PaymentType) Title() PaymentTypeTitle {
-	return mapPaymentTypeToTitle[pt]
+	if title, ok := mapPaymentTypeToTitle[pt]; ok {
+		return title
+	}
+	return PaymentTypeTitle(pt.humanize())
+}
+
+func (pt PaymentType) IndonesianTitle() PaymentTypeIndonesianTitle {
+	if title, ok := mapPaymentTypeToIndonesianTitle[pt]; ok {
+		return title
+	}
+	return PaymentTypeIndonesianTitle(pt.humanize())
+}
+
+// humanize turns a payment type code from an installment plan, such as second_installment,
+// into a readable title for the types that have no predefined one.
+func (pt PaymentType) humanize() string {
+	words := strings.Fields(strings.ReplaceAll(pt.String(), "_", " "))
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
 }
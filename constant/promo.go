@@ -0,0 +1,7 @@
+package constant
+
+var PromoDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+}
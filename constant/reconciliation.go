@@ -0,0 +1,21 @@
+package constant
+
+type ReconciliationAction string
+
+const (
+	ReconciliationApplied    ReconciliationAction = "applied"
+	ReconciliationDryRun     ReconciliationAction = "dry_run"
+	ReconciliationUnresolved ReconciliationAction = "unresolved"
+	ReconciliationFailed     ReconciliationAction = "failed"
+
+	ReconciliationFormatJSON = "json"
+	ReconciliationFormatCSV  = "csv"
+
+	PaymentEventPending    = "PENDING"
+	PaymentEventSettlement = "SETTLEMENT"
+	PaymentEventExpire     = "EXPIRE"
+)
+
+func (r ReconciliationAction) String() string {
+	return string(r)
+}
@@ -0,0 +1,20 @@
+package constant
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+
+	SubOrderDefaultSort = "createdAt"
+
+	PaginationModeOffset = "offset"
+	PaginationModeCursor = "cursor"
+)
+
+var SubOrderSortColumns = map[string]string{
+	"createdAt":    "sub_orders.created_at",
+	"updatedAt":    "sub_orders.updated_at",
+	"orderDate":    "sub_orders.order_date",
+	"amount":       "sub_orders.amount",
+	"subOrderName": "sub_orders.sub_order_name",
+	"status":       "sub_orders.status",
+}
@@ -4,33 +4,41 @@ type OrderStatus int
 type OrderStatusString string
 
 const (
-	Initial        OrderStatus = 0
-	Pending        OrderStatus = 100
-	PendingPayment OrderStatus = 200
-	PaymentSuccess OrderStatus = 300
-	Cancelled      OrderStatus = 400
-
-	InitialString        OrderStatusString = "initial"
-	PendingString        OrderStatusString = "pending"
-	PendingPaymentString OrderStatusString = "pending-payment"
-	PaymentSuccessString OrderStatusString = "payment-success"
-	CancelledString      OrderStatusString = "cancelled"
+	Initial           OrderStatus = 0
+	Pending           OrderStatus = 100
+	PendingPayment    OrderStatus = 200
+	PaymentSuccess    OrderStatus = 300
+	Cancelled         OrderStatus = 400
+	Refunded          OrderStatus = 500
+	PartiallyRefunded OrderStatus = 600
+
+	InitialString           OrderStatusString = "initial"
+	PendingString           OrderStatusString = "pending"
+	PendingPaymentString    OrderStatusString = "pending-payment"
+	PaymentSuccessString    OrderStatusString = "payment-success"
+	CancelledString         OrderStatusString = "cancelled"
+	RefundedString          OrderStatusString = "refunded"
+	PartiallyRefundedString OrderStatusString = "partially-refunded"
 )
 
 var mapOrderStatusIntToString = map[OrderStatus]OrderStatusString{
-	Initial:        InitialString,
-	Pending:        PendingString,
-	PendingPayment: PendingPaymentString,
-	PaymentSuccess: PaymentSuccessString,
-	Cancelled:      CancelledString,
+	Initial:           InitialString,
+	Pending:           PendingString,
+	PendingPayment:    PendingPaymentString,
+	PaymentSuccess:    PaymentSuccessString,
+	Cancelled:         CancelledString,
+	Refunded:          RefundedString,
+	PartiallyRefunded: PartiallyRefundedString,
 }
 
 var mapOrderStatusStringToInt = map[OrderStatusString]OrderStatus{
-	InitialString:        Initial,
-	PendingString:        Pending,
-	PendingPaymentString: PendingPayment,
-	PaymentSuccessString: PaymentSuccess,
-	CancelledString:      Cancelled,
+	InitialString:           Initial,
+	PendingString:           Pending,
+	PendingPaymentString:    PendingPayment,
+	PaymentSuccessString:    PaymentSuccess,
+	CancelledString:         Cancelled,
+	RefundedString:          Refunded,
+	PartiallyRefundedString: PartiallyRefunded,
 }
 
 func (o OrderStatusString) String() string {
@@ -4,6 +4,5 @@ const (
 	Prepaid  = "prepaid"
 	Postpaid = "postpaid"
 
-	PrepaidDisplayButton = "Link Pembayaran"
-	InvoiceButton        = "Invoice"
+	PaymentReminder = "payment-reminder"
 )
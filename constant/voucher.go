@@ -0,0 +1,12 @@
+package constant
+
+type VoucherDiscountType string
+
+const (
+	VoucherPercentage VoucherDiscountType = "percentage"
+	VoucherFixed      VoucherDiscountType = "fixed"
+)
+
+func (t VoucherDiscountType) String() string {
+	return string(t)
+}
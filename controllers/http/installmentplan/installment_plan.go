@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"net/http"
+
+	"order-service/common/sentry"
+	errorValidation "order-service/utils/error"
+	"order-service/utils/response"
+
+	installmentPlanDTO "order-service/domain/dto/installmentplan"
+	"order-service/services"
+)
+
+type IInstallmentPlanController interface {
+	CreateInstallmentPlan(c *gin.Context)
+	GetInstallmentPlanList(c *gin.Context)
+	AssignPackage(c *gin.Context)
+}
+
+type IInstallmentPlan struct {
+	serviceRegistry services.IServiceRegistry
+	sentry          sentry.ISentry
+}
+
+func NewInstallmentPlanController(
+	serviceRegistry services.IServiceRegistry,
+	sentry sentry.ISentry,
+) IInstallmentPlanController {
+	return &IInstallmentPlan{
+		serviceRegistry: serviceRegistry,
+		sentry:          sentry,
+	}
+}
+
+//nolint:dupl
+func (o *IInstallmentPlan) CreateInstallmentPlan(c *gin.Context) {
+	const logCtx = "controllers.http.installmentplan.installment_plan.CreateInstallmentPlan"
+	var (
+		ctx     = c.Request.Context()
+		request = installmentPlanDTO.InstallmentPlanRequest{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	installmentPlan, err := o.serviceRegistry.GetInstallmentPlan().CreateInstallmentPlan(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: installmentPlan,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+//nolint:dupl
+func (o *IInstallmentPlan) GetInstallmentPlanList(c *gin.Context) {
+	const logCtx = "controllers.http.installmentplan.installment_plan.GetInstallmentPlanList"
+	var (
+		ctx     = c.Request.Context()
+		request = installmentPlanDTO.InstallmentPlanRequestParam{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	installmentPlans, err := o.serviceRegistry.GetInstallmentPlan().GetInstallmentPlanList(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: installmentPlans,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *IInstallmentPlan) AssignPackage(c *gin.Context) {
+	const logCtx = "controllers.http.installmentplan.installment_plan.AssignPackage"
+	var (
+		ctx      = c.Request.Context()
+		planUUID = c.Param("uuid")
+		request  = installmentPlanDTO.AssignPackageRequest{}
+		span     = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	installmentPlan, err := o.serviceRegistry.GetInstallmentPlan().AssignPackage(ctx, planUUID, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: installmentPlan,
+		Err:  err,
+		Gin:  c,
+	})
+}
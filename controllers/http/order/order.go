@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"net/http"
+
+	"order-service/common/sentry"
+	"order-service/utils/response"
+
+	"order-service/services"
+)
+
+type IOrderController interface {
+	GetOrderDetail(c *gin.Context)
+}
+
+type IOrder struct {
+	serviceRegistry services.IServiceRegistry
+	sentry          sentry.ISentry
+}
+
+func NewOrderController(
+	serviceRegistry services.IServiceRegistry,
+	sentry sentry.ISentry,
+) IOrderController {
+	return &IOrder{
+		serviceRegistry: serviceRegistry,
+		sentry:          sentry,
+	}
+}
+
+func (o *IOrder) GetOrderDetail(c *gin.Context) {
+	const logCtx = "controllers.http.order.order.GetOrderDetail"
+	var (
+		ctx       = c.Request.Context()
+		orderUUID = c.Param("orderUUID")
+		span      = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.serviceRegistry.GetOrder().GetOrderDetail(ctx, orderUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: order,
+		Err:  err,
+		Gin:  c,
+	})
+}
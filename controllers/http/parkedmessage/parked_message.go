@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"net/http"
+
+	"order-service/common/sentry"
+	errorValidation "order-service/utils/error"
+	"order-service/utils/response"
+
+	kafkaRegistry "order-service/controllers/kafka"
+	parkedMessageDTO "order-service/domain/dto/parkedmessage"
+	"order-service/services"
+)
+
+type IParkedMessageController interface {
+	GetParkedMessageList(c *gin.Context)
+	ReplayParkedMessage(c *gin.Context)
+}
+
+type IParkedMessage struct {
+	serviceRegistry services.IServiceRegistry
+	kafkaRegistry   kafkaRegistry.IKafkaRegistry
+	sentry          sentry.ISentry
+}
+
+func NewParkedMessageController(
+	serviceRegistry services.IServiceRegistry,
+	kafkaRegistry kafkaRegistry.IKafkaRegistry,
+	sentry sentry.ISentry,
+) IParkedMessageController {
+	return &IParkedMessage{
+		serviceRegistry: serviceRegistry,
+		kafkaRegistry:   kafkaRegistry,
+		sentry:          sentry,
+	}
+}
+
+//nolint:dupl
+func (o *IParkedMessage) GetParkedMessageList(c *gin.Context) {
+	const logCtx = "controllers.http.parkedmessage.parked_message.GetParkedMessageList"
+	var (
+		ctx     = c.Request.Context()
+		request = parkedMessageDTO.ParkedMessageRequestParam{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	parkedMessages, err := o.serviceRegistry.GetParkedMessage().GetParkedMessageList(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: parkedMessages,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *IParkedMessage) ReplayParkedMessage(c *gin.Context) {
+	const logCtx = "controllers.http.parkedmessage.parked_message.ReplayParkedMessage"
+	var (
+		ctx               = c.Request.Context()
+		parkedMessageUUID = c.Param("uuid")
+		span              = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	parkedMessage, err := o.serviceRegistry.GetParkedMessage().
+		Replay(ctx, parkedMessageUUID, o.kafkaRegistry.GetTopicHandler)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: parkedMessage,
+		Err:  err,
+		Gin:  c,
+	})
+}
@@ -2,25 +2,37 @@ package controllers
 
 import (
 	"order-service/common/sentry"
+	installmentPlanController "order-service/controllers/http/installmentplan"
+	orderController "order-service/controllers/http/order"
+	parkedMessageRoute "order-service/controllers/http/parkedmessage"
 	orderRoute "order-service/controllers/http/suborder"
+	voucherController "order-service/controllers/http/voucher"
+	kafkaRegistry "order-service/controllers/kafka"
 	serviceRegistry "order-service/services"
 )
 
 type IControllerRegistry interface {
 	GetSubOrder() orderRoute.ISubOrderController
+	GetParkedMessage() parkedMessageRoute.IParkedMessageController
+	GetOrder() orderController.IOrderController
+	GetInstallmentPlan() installmentPlanController.IInstallmentPlanController
+	GetVoucher() voucherController.IVoucherController
 }
 
 type ControllerRegistry struct {
 	service serviceRegistry.IServiceRegistry
+	kafka   kafkaRegistry.IKafkaRegistry
 	sentry  sentry.ISentry
 }
 
 func NewControllerRegistry(
 	service serviceRegistry.IServiceRegistry,
+	kafka kafkaRegistry.IKafkaRegistry,
 	sentry sentry.ISentry,
 ) IControllerRegistry {
 	return &ControllerRegistry{
 		service: service,
+		kafka:   kafka,
 		sentry:  sentry,
 	}
 }
@@ -28,3 +40,19 @@ func NewControllerRegistry(
 func (r *ControllerRegistry) GetSubOrder() orderRoute.ISubOrderController {
 	return orderRoute.NewOrderController(r.service, r.sentry)
 }
+
+func (r *ControllerRegistry) GetParkedMessage() parkedMessageRoute.IParkedMessageController {
+	return parkedMessageRoute.NewParkedMessageController(r.service, r.kafka, r.sentry)
+}
+
+func (r *ControllerRegistry) GetOrder() orderController.IOrderController {
+	return orderController.NewOrderController(r.service, r.sentry)
+}
+
+func (r *ControllerRegistry) GetInstallmentPlan() installmentPlanController.IInstallmentPlanController {
+	return installmentPlanController.NewInstallmentPlanController(r.service, r.sentry)
+}
+
+func (r *ControllerRegistry) GetVoucher() voucherController.IVoucherController {
+	return voucherController.NewVoucherController(r.service, r.sentry)
+}
@@ -7,9 +7,11 @@ import (
 	"net/http"
 
 	"order-service/common/sentry"
+	"order-service/constant"
 	errorValidation "order-service/utils/error"
 	"order-service/utils/response"
 
+	orderRefundDTO "order-service/domain/dto/orderrefund"
 	orderDTO "order-service/domain/dto/suborder"
 	"order-service/services"
 )
@@ -19,6 +21,11 @@ type ISubOrderController interface {
 	GetSubOrderList(c *gin.Context)
 	GetSubOrderDetail(c *gin.Context)
 	CancelOrder(c *gin.Context)
+	RefundOrder(c *gin.Context)
+	RegeneratePaymentLink(c *gin.Context)
+	GetMyOrderList(c *gin.Context)
+	GetMyOrderDetail(c *gin.Context)
+	CancelMyOrder(c *gin.Context)
 }
 
 type ISubOrder struct {
@@ -129,7 +136,12 @@ func (o *ISubOrder) GetSubOrderList(c *gin.Context) {
 		return
 	}
 
-	order, err := o.serviceRegistry.GetSubOrder().GetSubOrderList(ctx, &request)
+	var order any
+	if request.PaginationMode == constant.PaginationModeCursor {
+		order, err = o.serviceRegistry.GetSubOrder().GetSubOrderListByCursor(ctx, &request)
+	} else {
+		order, err = o.serviceRegistry.GetSubOrder().GetSubOrderList(ctx, &request)
+	}
 	if err != nil {
 		response.HTTPResponse(response.ParamHTTPResp{
 			Code:   http.StatusBadRequest,
@@ -205,3 +217,203 @@ func (o *ISubOrder) CancelOrder(c *gin.Context) {
 		Gin:  c,
 	})
 }
+
+func (o *ISubOrder) RegeneratePaymentLink(c *gin.Context) {
+	const logCtx = "controllers.http.suborder.sub_order.RegeneratePaymentLink"
+	var (
+		ctx       = c.Request.Context()
+		orderUUID = c.Param("uuid")
+		span      = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.serviceRegistry.GetSubOrder().RegeneratePaymentLink(ctx, orderUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: order,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *ISubOrder) RefundOrder(c *gin.Context) {
+	const logCtx = "controllers.http.suborder.sub_order.RefundOrder"
+	var (
+		ctx       = c.Request.Context()
+		orderUUID = c.Param("uuid")
+		request   = orderRefundDTO.RefundRequest{}
+		span      = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	refund, err := o.serviceRegistry.GetSubOrder().Refund(ctx, orderUUID, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: refund,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+//nolint:dupl
+func (o *ISubOrder) GetMyOrderList(c *gin.Context) {
+	const logCtx = "controllers.http.suborder.sub_order.GetMyOrderList"
+	var (
+		ctx     = c.Request.Context()
+		request = orderDTO.SubOrderRequestParam{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindQuery(&request)
+	request.PaginationMode = constant.PaginationModeOffset
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	order, err := o.serviceRegistry.GetSubOrder().GetMyOrderList(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: order,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *ISubOrder) GetMyOrderDetail(c *gin.Context) {
+	const logCtx = "controllers.http.suborder.sub_order.GetMyOrderDetail"
+	var (
+		ctx       = c.Request.Context()
+		orderUUID = c.Param("uuid")
+		span      = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.serviceRegistry.GetSubOrder().GetMyOrderDetail(ctx, orderUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: order,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *ISubOrder) CancelMyOrder(c *gin.Context) {
+	const logCtx = "controllers.http.suborder.sub_order.CancelMyOrder"
+	var (
+		ctx       = c.Request.Context()
+		orderUUID = c.Param("uuid")
+		span      = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.serviceRegistry.GetSubOrder().CancelMyOrder(ctx, orderUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: nil,
+		Err:  err,
+		Gin:  c,
+	})
+}
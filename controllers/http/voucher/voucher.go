@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"net/http"
+
+	"order-service/common/sentry"
+	errorValidation "order-service/utils/error"
+	"order-service/utils/response"
+
+	voucherDTO "order-service/domain/dto/voucher"
+	"order-service/services"
+)
+
+type IVoucherController interface {
+	CreateVoucher(c *gin.Context)
+	GetVoucherList(c *gin.Context)
+	GetVoucherDetail(c *gin.Context)
+	UpdateVoucher(c *gin.Context)
+	DeleteVoucher(c *gin.Context)
+}
+
+type IVoucher struct {
+	serviceRegistry services.IServiceRegistry
+	sentry          sentry.ISentry
+}
+
+func NewVoucherController(
+	serviceRegistry services.IServiceRegistry,
+	sentry sentry.ISentry,
+) IVoucherController {
+	return &IVoucher{
+		serviceRegistry: serviceRegistry,
+		sentry:          sentry,
+	}
+}
+
+//nolint:dupl
+func (o *IVoucher) CreateVoucher(c *gin.Context) {
+	const logCtx = "controllers.http.voucher.voucher.CreateVoucher"
+	var (
+		ctx     = c.Request.Context()
+		request = voucherDTO.VoucherRequest{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	voucher, err := o.serviceRegistry.GetVoucher().CreateVoucher(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: voucher,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+//nolint:dupl
+func (o *IVoucher) GetVoucherList(c *gin.Context) {
+	const logCtx = "controllers.http.voucher.voucher.GetVoucherList"
+	var (
+		ctx     = c.Request.Context()
+		request = voucherDTO.VoucherRequestParam{}
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	vouchers, err := o.serviceRegistry.GetVoucher().GetVoucherList(ctx, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: vouchers,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *IVoucher) GetVoucherDetail(c *gin.Context) {
+	const logCtx = "controllers.http.voucher.voucher.GetVoucherDetail"
+	var (
+		ctx         = c.Request.Context()
+		voucherUUID = c.Param("uuid")
+		span        = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	voucher, err := o.serviceRegistry.GetVoucher().GetVoucherDetail(ctx, voucherUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: voucher,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *IVoucher) UpdateVoucher(c *gin.Context) {
+	const logCtx = "controllers.http.voucher.voucher.UpdateVoucher"
+	var (
+		ctx         = c.Request.Context()
+		voucherUUID = c.Param("uuid")
+		request     = voucherDTO.VoucherRequest{}
+		span        = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errorValidation.ErrorValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Sentry:  o.sentry,
+			Gin:     c,
+		})
+		return
+	}
+
+	voucher, err := o.serviceRegistry.GetVoucher().UpdateVoucher(ctx, voucherUUID, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: voucher,
+		Err:  err,
+		Gin:  c,
+	})
+}
+
+func (o *IVoucher) DeleteVoucher(c *gin.Context) {
+	const logCtx = "controllers.http.voucher.voucher.DeleteVoucher"
+	var (
+		ctx         = c.Request.Context()
+		voucherUUID = c.Param("uuid")
+		span        = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.serviceRegistry.GetVoucher().DeleteVoucher(ctx, voucherUUID)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:   http.StatusBadRequest,
+			Err:    err,
+			Gin:    c,
+			Sentry: o.sentry,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Err:  err,
+		Gin:  c,
+	})
+}
@@ -2,8 +2,8 @@ package kafka
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"order-service/config"
 	"sync"
 	"time"
 
@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
+	"order-service/common/metrics"
+	"order-service/common/sentry"
 	"order-service/constant"
 )
 
@@ -20,35 +22,77 @@ type (
 		Headers map[string][]byte
 		Value   []byte
 	}
-	TopicName string
-	Handler   func(ctx context.Context, message *sarama.ConsumerMessage) error
+	TopicName         string
+	Handler           func(ctx context.Context, message *sarama.ConsumerMessage) error
+	DeadLetterHandler func(ctx context.Context, message *sarama.ConsumerMessage, err error, retries int) error
+	Option            func(*ConsumerGroup)
 )
 
+type topicHandler struct {
+	handler     Handler
+	retryPolicy RetryPolicy
+}
+
 type ConsumerGroup struct {
-	mu          *sync.Mutex
-	isReady     chan bool
-	keepRunning bool
-	handlers    map[TopicName]Handler
-	retryWg     sync.WaitGroup
+	mu                *sync.Mutex
+	isReady           chan bool
+	readyOnce         sync.Once
+	joined            bool
+	claims            map[string][]int32
+	keepRunning       bool
+	handlers          map[TopicName]topicHandler
+	deadLetterHandler DeadLetterHandler
+	sentry            sentry.ISentry
+}
+
+func WithDeadLetterHandler(handler DeadLetterHandler) Option {
+	return func(c *ConsumerGroup) {
+		c.deadLetterHandler = handler
+	}
 }
 
-func NewConsumer() *ConsumerGroup {
-	return &ConsumerGroup{
+// WithSentry continues the trace of the producer for every message read from the topics.
+func WithSentry(sentry sentry.ISentry) Option {
+	return func(c *ConsumerGroup) {
+		c.sentry = sentry
+	}
+}
+
+func NewConsumer(options ...Option) *ConsumerGroup {
+	consumer := &ConsumerGroup{
 		mu:          &sync.Mutex{},
 		isReady:     make(chan bool),
 		keepRunning: true,
-		handlers:    make(map[TopicName]Handler),
+		handlers:    make(map[TopicName]topicHandler),
+	}
+
+	for _, option := range options {
+		option(consumer)
 	}
+
+	return consumer
 }
 
-func (c *ConsumerGroup) Setup(sarama.ConsumerGroupSession) error {
+// Setup runs on every rebalance, the ready channel is only closed for the first session.
+func (c *ConsumerGroup) Setup(session sarama.ConsumerGroupSession) error {
 	log.Infof("ConsumerGroup setup done")
-	close(c.isReady)
+	c.mu.Lock()
+	c.joined = true
+	c.claims = session.Claims()
+	c.mu.Unlock()
+
+	c.readyOnce.Do(func() {
+		close(c.isReady)
+	})
 	return nil
 }
 
 func (c *ConsumerGroup) Cleanup(sarama.ConsumerGroupSession) error {
 	log.Infof("ConsumerGroup cleanup")
+	c.mu.Lock()
+	c.joined = false
+	c.claims = nil
+	c.mu.Unlock()
 	return nil
 }
 
@@ -88,30 +132,32 @@ func (c *ConsumerGroup) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 				ctx = c.generateRequestID(ctx, &requestID)
 			}
 
-			var err error
-			retries := config.Config.KafkaMaxRetry
-
-			for retries > 0 {
-				c.retryWg.Add(1)
-				go func(retries int) {
-					defer c.Recover()
-					defer c.retryWg.Done()
-					err = handler(ctx, message)
-				}(retries)
-
-				c.retryWg.Wait()
-
-				if err == nil {
-					break
-				}
-
-				log.Errorf("Error handling message: %v. Retrying...", err)
-				retries--
+			event := eventName(message)
+			messageCtx, span := c.startSpan(ctx, message)
+			attempts, err := c.handle(session.Context(), messageCtx, handler, message)
+			if attempts > 1 {
+				metrics.KafkaMessagesTotal.WithLabelValues(message.Topic, event, metrics.ResultRetried).Add(float64(attempts - 1))
+			}
+			if err != nil && session.Context().Err() != nil {
+				// the session is closing, leave the message unmarked so it is redelivered
+				c.finishSpan(span)
+				return nil
 			}
 
 			if err != nil {
-				log.Errorf("Error handling message after retries: %v", err)
+				log.Errorf("Error handling message after %d attempt(s): %v", attempts, err)
+				metrics.KafkaMessagesTotal.WithLabelValues(message.Topic, event, metrics.ResultFailed).Inc()
+				errDeadLetter := c.deadLetter(messageCtx, message, err, attempts)
+				if errDeadLetter != nil {
+					// the message is neither handled nor parked, end the claim without marking it so
+					// the session restarts from the last committed offset and delivers it again
+					c.finishSpan(span)
+					return errDeadLetter
+				}
+			} else {
+				metrics.KafkaMessagesTotal.WithLabelValues(message.Topic, event, metrics.ResultSuccess).Inc()
 			}
+			c.finishSpan(span)
 
 			session.MarkMessage(message, time.Now().UTC().String())
 
@@ -121,10 +167,137 @@ func (c *ConsumerGroup) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 	}
 }
 
+// handle runs the handler until it succeeds, the error is classified as not retryable or the
+// retry policy of the topic is exhausted, waiting with backoff between attempts.
+func (c *ConsumerGroup) handle(
+	sessionCtx context.Context,
+	ctx context.Context,
+	handler topicHandler,
+	message *sarama.ConsumerMessage,
+) (int, error) {
+	var (
+		err     error
+		attempt int
+		policy  = handler.retryPolicy
+	)
+
+	for attempt = 1; attempt <= policy.MaxAttempts; attempt++ {
+		err = c.execute(ctx, handler.handler, message)
+		if err == nil {
+			return attempt, nil
+		}
+
+		if !policy.ShouldRetry(err) {
+			log.Errorf("Error handling message is not retryable: %v", err)
+			return attempt, err
+		}
+
+		if attempt == policy.MaxAttempts {
+			break
+		}
+
+		backoff := policy.Backoff(attempt)
+		log.Errorf("Error handling message: %v. Retrying in %s...", err, backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-sessionCtx.Done():
+			timer.Stop()
+			return attempt, err
+		}
+	}
+
+	return policy.MaxAttempts, err
+}
+
+func (c *ConsumerGroup) execute(ctx context.Context, handler Handler, message *sarama.ConsumerMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("recovered from panic: %v", r)
+			err = fmt.Errorf("panic handling message: %v", r) //nolint:goerr113
+		}
+	}()
+
+	return handler(ctx, message)
+}
+
+func (c *ConsumerGroup) deadLetter(
+	ctx context.Context,
+	message *sarama.ConsumerMessage,
+	err error,
+	retries int,
+) error {
+	if c.deadLetterHandler == nil {
+		return nil
+	}
+
+	errDeadLetter := c.deadLetterHandler(ctx, message, err, retries)
+	if errDeadLetter != nil {
+		log.Errorf("failed to park message from topic %s partition %d offset %d: %v",
+			message.Topic, message.Partition, message.Offset, errDeadLetter)
+		return errDeadLetter
+	}
+	return nil
+}
+
+// startSpan continues the trace carried in the message headers, messages from producers that do
+// not propagate a trace start a new one.
+func (c *ConsumerGroup) startSpan(ctx context.Context, message *sarama.ConsumerMessage) (context.Context, sentry.Span) {
+	if c.sentry == nil {
+		return ctx, nil
+	}
+
+	headers := make(map[string]string, len(message.Headers))
+	for _, header := range message.Headers {
+		headers[string(header.Key)] = string(header.Value)
+	}
+
+	span := c.sentry.StartSpan(c.sentry.Extract(ctx, headers), fmt.Sprintf("kafka.consume %s", message.Topic))
+	return c.sentry.SpanContext(span), span
+}
+
+func (c *ConsumerGroup) finishSpan(span sentry.Span) {
+	if span == nil {
+		return
+	}
+	c.sentry.Finish(span)
+}
+
+// handledEvents are the event names used as metric labels, any other name is reported as unknown
+// so a producer sending arbitrary names cannot grow the number of series.
+var handledEvents = map[string]bool{
+	constant.PaymentEventPending:    true,
+	constant.PaymentEventSettlement: true,
+	constant.PaymentEventExpire:     true,
+}
+
+// eventName reads the event name from the message envelope shared by the services, it is only
+// used to label metrics so an unreadable message or an unhandled event is reported as unknown.
+func eventName(message *sarama.ConsumerMessage) string {
+	var envelope struct {
+		Event struct {
+			Name string `json:"name"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(message.Value, &envelope); err != nil || !handledEvents[envelope.Event.Name] {
+		return "unknown"
+	}
+	return envelope.Event.Name
+}
+
 func (c *ConsumerGroup) SetIsReady() {
 	<-c.isReady
 }
 
+// Status returns whether the consumer is part of a group session and the partitions assigned to
+// it by topic.
+func (c *ConsumerGroup) Status() (bool, map[string][]int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.joined, c.claims
+}
+
 func (c *ConsumerGroup) KeepRunning() bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -139,11 +312,14 @@ func (c *ConsumerGroup) Recover() {
 	}
 }
 
-func (c *ConsumerGroup) RegisterTopicHandler(topicName TopicName, handler Handler) {
+func (c *ConsumerGroup) RegisterTopicHandler(topicName TopicName, handler Handler, retryPolicy RetryPolicy) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.handlers[topicName] = handler
+	c.handlers[topicName] = topicHandler{
+		handler:     handler,
+		retryPolicy: retryPolicy,
+	}
 	log.Infof(fmt.Sprintf("registered handler: %s", topicName))
 }
 
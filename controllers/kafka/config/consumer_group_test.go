@@ -0,0 +1,29 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func TestEventName(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "handled event", value: `{"event":{"name":"SETTLEMENT"}}`, want: "SETTLEMENT"},
+		{name: "unhandled event", value: `{"event":{"name":"REFUND_9f1c"}}`, want: "unknown"},
+		{name: "missing name", value: `{"event":{}}`, want: "unknown"},
+		{name: "malformed payload", value: `{"event":`, want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := eventName(&sarama.ConsumerMessage{Value: []byte(tt.value)})
+			if got != tt.want {
+				t.Errorf("eventName(%s) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -34,6 +34,10 @@ func (r *KafkaRouter) Register() {
 
 func (r *KafkaRouter) paymentHandler() {
 	if slices.Contains(config.Config.KafkaConsumerTopics, paymentTopic.PaymentTopic) {
-		r.consumer.RegisterTopicHandler(paymentTopic.PaymentTopic, r.kafkaRegistry.GetPayment().HandlePayment)
+		r.consumer.RegisterTopicHandler(
+			paymentTopic.PaymentTopic,
+			r.kafkaRegistry.GetPayment().HandlePayment,
+			NewRetryPolicy(paymentTopic.PaymentTopic),
+		)
 	}
 }
@@ -0,0 +1,105 @@
+package kafka
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"order-service/config"
+	errorGeneral "order-service/constant/error"
+)
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+	defaultMultiplier     = 2
+	defaultJitter         = 0.2
+)
+
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+	IsRetryable    func(error) bool
+}
+
+// NewRetryPolicy builds the policy of a topic from kafkaRetryPolicies, any value left empty
+// falls back to kafkaMaxRetry and kafkaConsumerBackoffTimeInMs.
+func NewRetryPolicy(topicName TopicName) RetryPolicy {
+	policy := RetryPolicy{
+		MaxAttempts:    config.Config.KafkaMaxRetry,
+		InitialBackoff: time.Duration(config.Config.KafkaConsumerBackoffTimeInMs) * time.Millisecond,
+		MaxBackoff:     defaultMaxBackoff,
+		Multiplier:     defaultMultiplier,
+		Jitter:         defaultJitter,
+		IsRetryable:    IsRetryable,
+	}
+
+	topicPolicy, exists := config.Config.KafkaRetryPolicies[string(topicName)]
+	if exists {
+		if topicPolicy.MaxAttempt > 0 {
+			policy.MaxAttempts = topicPolicy.MaxAttempt
+		}
+		if topicPolicy.InitialBackoffInMs > 0 {
+			policy.InitialBackoff = time.Duration(topicPolicy.InitialBackoffInMs) * time.Millisecond
+		}
+		if topicPolicy.MaxBackoffInMs > 0 {
+			policy.MaxBackoff = time.Duration(topicPolicy.MaxBackoffInMs) * time.Millisecond
+		}
+		if topicPolicy.Multiplier >= 1 {
+			policy.Multiplier = topicPolicy.Multiplier
+		}
+		if topicPolicy.Jitter > 0 && topicPolicy.Jitter <= 1 {
+			policy.Jitter = topicPolicy.Jitter
+		}
+	}
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = defaultInitialBackoff
+	}
+
+	return policy
+}
+
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delta := p.Jitter * backoff
+		backoff = backoff - delta + rand.Float64()*2*delta //nolint:gosec
+	}
+
+	return time.Duration(backoff)
+}
+
+func (p RetryPolicy) ShouldRetry(err error) bool {
+	if p.IsRetryable == nil {
+		return true
+	}
+	return p.IsRetryable(err)
+}
+
+// IsRetryable treats malformed payloads and the known business errors as permanent failures,
+// anything else such as database or circuit breaker errors is retried.
+func IsRetryable(err error) bool {
+	var (
+		syntaxError        *json.SyntaxError
+		unmarshalTypeError *json.UnmarshalTypeError
+	)
+	if errors.As(err, &syntaxError) || errors.As(err, &unmarshalTypeError) {
+		return false
+	}
+
+	return errorGeneral.IsRetryable(err)
+}
@@ -0,0 +1,129 @@
+package kafka
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, want: 100 * time.Millisecond},
+		{name: "second attempt", attempt: 2, want: 200 * time.Millisecond},
+		{name: "fourth attempt", attempt: 4, want: 800 * time.Millisecond},
+		{name: "capped at the max backoff", attempt: 5, want: time.Second},
+		{name: "stays at the max backoff", attempt: 20, want: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Backoff(tt.attempt)
+			if got != tt.want {
+				t.Errorf("Backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoffJitter(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+
+	tests := []struct {
+		name     string
+		attempt  int
+		min, max time.Duration
+	}{
+		{name: "first attempt", attempt: 1, min: 80 * time.Millisecond, max: 120 * time.Millisecond},
+		{name: "third attempt", attempt: 3, min: 320 * time.Millisecond, max: 480 * time.Millisecond},
+		{name: "capped at the max backoff", attempt: 10, min: 800 * time.Millisecond, max: 1200 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := policy.Backoff(tt.attempt)
+				if got < tt.min || got > tt.max {
+					t.Fatalf("Backoff(%d) = %s, want between %s and %s", tt.attempt, got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	var syntaxError error = &json.SyntaxError{}
+	var unmarshalTypeError error = &json.UnmarshalTypeError{}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "database error", err: errorGeneral.ErrSQLError, want: true},
+		{name: "open circuit breaker", err: errorGeneral.ErrOpenState, want: true},
+		{name: "unknown error", err: errors.New("connection reset"), want: true},
+		{name: "malformed payload", err: syntaxError, want: false},
+		{name: "wrong payload type", err: unmarshalTypeError, want: false},
+		{name: "wrapped malformed payload", err: fmt.Errorf("decode: %w", syntaxError), want: false},
+		{name: "duplicate event", err: errorGeneral.ErrDuplicateEvent, want: false},
+		{name: "invalid status transition", err: errorGeneral.ErrInvalidStatusTransition, want: false},
+		{name: "order error", err: errOrder.ErrOrderNotFound, want: false},
+		{name: "wrapped order error", err: fmt.Errorf("process: %w", errOrder.ErrOrderNotFound), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsRetryable(tt.err)
+			if got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		err    error
+		want   bool
+	}{
+		{name: "without classifier", policy: RetryPolicy{}, err: errorGeneral.ErrDuplicateEvent, want: true},
+		{name: "retryable", policy: RetryPolicy{IsRetryable: IsRetryable}, err: errorGeneral.ErrSQLError, want: true},
+		{
+			name:   "not retryable",
+			policy: RetryPolicy{IsRetryable: IsRetryable},
+			err:    errorGeneral.ErrDuplicateEvent,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.ShouldRetry(tt.err)
+			if got != tt.want {
+				t.Errorf("ShouldRetry(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"order-service/common/sentry"
+	errorResp "order-service/utils/error"
+
+	parkedMessageDTO "order-service/domain/dto/parkedmessage"
+	serviceRegistry "order-service/services"
+)
+
+type DeadLetterKafka struct {
+	service serviceRegistry.IServiceRegistry
+	sentry  sentry.ISentry
+}
+
+type IDeadLetterKafka interface {
+	HandleDeadLetter(ctx context.Context, message *sarama.ConsumerMessage, err error, retries int) error
+}
+
+func NewDeadLetterKafka(
+	service serviceRegistry.IServiceRegistry,
+	sentry sentry.ISentry,
+) IDeadLetterKafka {
+	return &DeadLetterKafka{
+		service: service,
+		sentry:  sentry,
+	}
+}
+
+func (d *DeadLetterKafka) HandleDeadLetter(
+	ctx context.Context,
+	message *sarama.ConsumerMessage,
+	handlerErr error,
+	retries int,
+) error {
+	headers := make(map[string]string, len(message.Headers))
+	for _, header := range message.Headers {
+		if header == nil {
+			continue
+		}
+		headers[string(header.Key)] = string(header.Value)
+	}
+
+	var key *string
+	if message.Key != nil {
+		messageKey := string(message.Key)
+		key = &messageKey
+	}
+
+	err := d.service.GetParkedMessage().Park(ctx, &parkedMessageDTO.ParkedMessageRequest{
+		Topic:            message.Topic,
+		Partition:        message.Partition,
+		Offset:           message.Offset,
+		Key:              key,
+		Value:            string(message.Value),
+		Headers:          headers,
+		Error:            handlerErr.Error(),
+		RetryCount:       retries,
+		MessageTimestamp: message.Timestamp,
+		FailedAt:         time.Now(),
+	})
+	if err != nil {
+		return errorResp.WrapError(err, d.sentry)
+	}
+	return nil
+}
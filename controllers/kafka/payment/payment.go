@@ -55,8 +55,10 @@ func (p *PaymentKafka) HandlePayment(ctx context.Context, message *sarama.Consum
 		paymentType = constant.VirtualAccountBankTransfer
 	}
 	switch body.Event.Name {
-	case "PENDING":
+	case constant.PaymentEventPending:
 		err = p.service.GetSubOrder().ReceivePendingPayment(ctx, &paymentDTO.PaymentRequest{
+			MessageID:   body.Meta.MessageID,
+			Event:       string(body.Event.Name),
 			OrderID:     orderUUID,
 			PaymentID:   paymentUUID,
 			PaymentLink: data.PaymentLink,
@@ -67,8 +69,10 @@ func (p *PaymentKafka) HandlePayment(ctx context.Context, message *sarama.Consum
 			Bank:        data.Bank,
 			Acquirer:    data.Acquirer,
 		})
-	case "SETTLEMENT":
+	case constant.PaymentEventSettlement:
 		err = p.service.GetSubOrder().ReceivePaymentSettlement(ctx, &paymentDTO.PaymentRequest{
+			MessageID:   body.Meta.MessageID,
+			Event:       string(body.Event.Name),
 			OrderID:     orderUUID,
 			PaymentID:   paymentUUID,
 			PaymentLink: data.PaymentLink,
@@ -80,8 +84,10 @@ func (p *PaymentKafka) HandlePayment(ctx context.Context, message *sarama.Consum
 			Acquirer:    data.Acquirer,
 			PaidAt:      data.PaidAt,
 		})
-	case "EXPIRE":
+	case constant.PaymentEventExpire:
 		err = p.service.GetSubOrder().ReceivePaymentExpire(ctx, &paymentDTO.PaymentRequest{
+			MessageID: body.Meta.MessageID,
+			Event:     string(body.Event.Name),
 			OrderID:   orderUUID,
 			PaymentID: paymentUUID,
 			Status:    data.Status,
@@ -1,7 +1,12 @@
 package controllers
 
 import (
+	"context"
+
+	"github.com/IBM/sarama"
+
 	"order-service/common/sentry"
+	deadLetterKafka "order-service/controllers/kafka/deadletter"
 	paymentKafka "order-service/controllers/kafka/payment"
 	serviceRegistry "order-service/services"
 )
@@ -13,6 +18,8 @@ type Registry struct {
 
 type IKafkaRegistry interface {
 	GetPayment() paymentKafka.IPaymentKafka
+	GetDeadLetter() deadLetterKafka.IDeadLetterKafka
+	GetTopicHandler(topic string) func(context.Context, *sarama.ConsumerMessage) error
 }
 
 func NewKafkaRegistry(
@@ -28,3 +35,16 @@ func NewKafkaRegistry(
 func (r *Registry) GetPayment() paymentKafka.IPaymentKafka {
 	return paymentKafka.NewPaymentKafka(r.service, r.sentry)
 }
+
+func (r *Registry) GetDeadLetter() deadLetterKafka.IDeadLetterKafka {
+	return deadLetterKafka.NewDeadLetterKafka(r.service, r.sentry)
+}
+
+func (r *Registry) GetTopicHandler(topic string) func(context.Context, *sarama.ConsumerMessage) error {
+	switch topic {
+	case paymentKafka.PaymentTopic:
+		return r.GetPayment().HandlePayment
+	default:
+		return nil
+	}
+}
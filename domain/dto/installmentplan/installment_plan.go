@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	"order-service/constant"
+
+	"time"
+)
+
+type InstallmentPlanRequest struct {
+	Name        string                       `json:"name" validate:"required,max=100"`
+	Description string                       `json:"description" validate:"max=255"`
+	IsDefault   bool                         `json:"isDefault"`
+	Items       []InstallmentPlanItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type InstallmentPlanItemRequest struct {
+	PaymentType        constant.PaymentType           `json:"paymentType" validate:"required,max=30"`
+	Title              string                         `json:"title" validate:"required,max=100"`
+	IndonesianTitle    string                         `json:"indonesianTitle" validate:"required,max=100"`
+	AmountType         constant.InstallmentAmountType `json:"amountType" validate:"required,oneof=percentage fixed remaining_percentage remaining package_down_payment"` //nolint:lll
+	Value              float64                        `json:"value" validate:"gte=0"`
+	Amount             money.Money                    `json:"amount" validate:"gte=0"`
+	IsOptional         bool                           `json:"isOptional"`
+	DueDateOffsetInDay *int                           `json:"dueDateOffsetInDay"`
+}
+
+type AssignPackageRequest struct {
+	PackageID uuid.UUID `json:"packageID" validate:"required"`
+}
+
+type InstallmentPlanRequestParam struct {
+	Page  int `form:"page" validate:"required"`
+	Limit int `form:"limit" validate:"required"`
+}
+
+type ValidateInstallmentRequest struct {
+	PaymentType        constant.PaymentType
+	Amount             money.Money
+	OrderDate          time.Time
+	PackagePrice       money.Money
+	Currency           constant.Currency
+	MinimalDownPayment int
+	IsFirstInstallment bool
+}
+
+type InstallmentPlanResponse struct {
+	UUID        uuid.UUID                     `json:"uuid"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	IsDefault   bool                          `json:"isDefault"`
+	Items       []InstallmentPlanItemResponse `json:"items"`
+	CreatedAt   *time.Time                    `json:"createdAt"`
+	UpdatedAt   *time.Time                    `json:"updatedAt"`
+}
+
+type InstallmentPlanItemResponse struct {
+	Sequence           int                            `json:"sequence"`
+	PaymentType        constant.PaymentType           `json:"paymentType"`
+	Title              string                         `json:"title"`
+	IndonesianTitle    string                         `json:"indonesianTitle"`
+	AmountType         constant.InstallmentAmountType `json:"amountType"`
+	Value              float64                        `json:"value"`
+	Amount             money.Money                    `json:"amount"`
+	IsOptional         bool                           `json:"isOptional"`
+	DueDateOffsetInDay *int                           `json:"dueDateOffsetInDay"`
+}
@@ -9,6 +9,7 @@ type KafkaMessageEvent struct {
 }
 
 type KafkaMessageMeta struct {
+	MessageID *string    `json:"messageID,omitempty"`
 	Sender    string     `json:"sender"`
 	SendingAt time.Time  `json:"sendingAt"`
 	ExpiredAt *time.Time `json:"expiredAt"`
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	dto "order-service/domain/dto/kafka"
+)
+
+const (
+	OrderCreated           dto.EventName = "ORDER_CREATED"
+	SubOrderCreated        dto.EventName = "SUB_ORDER_CREATED"
+	SubOrderPendingPayment dto.EventName = "SUB_ORDER_PENDING_PAYMENT"
+	SubOrderPaid           dto.EventName = "SUB_ORDER_PAID"
+	SubOrderCancelled      dto.EventName = "SUB_ORDER_CANCELLED"
+	SubOrderReopened       dto.EventName = "SUB_ORDER_REOPENED"
+	OrderCompleted         dto.EventName = "ORDER_COMPLETED"
+
+	OrderDataType dto.DataType = "order"
+)
+
+type OrderData struct {
+	OrderID                    uuid.UUID   `json:"order_id"`
+	OrderName                  string      `json:"order_name"`
+	SubOrderID                 uuid.UUID   `json:"sub_order_id"`
+	SubOrderName               string      `json:"sub_order_name"`
+	CustomerID                 string      `json:"customer_id"`
+	PackageID                  string      `json:"package_id"`
+	PaymentType                string      `json:"payment_type"`
+	Amount                     money.Money `json:"amount"`
+	Currency                   string      `json:"currency"`
+	RemainingOutstandingAmount money.Money `json:"remaining_outstanding_amount"`
+	Status                     string      `json:"status"`
+	IsPaid                     bool        `json:"is_paid"`
+	OrderDate                  time.Time   `json:"order_date"`
+	CanceledAt                 *time.Time  `json:"canceled_at"`
+	CompletedAt                *time.Time  `json:"completed_at"`
+}
@@ -1,15 +1,90 @@
 package dto
 
-import "time"
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/locale"
+	"order-service/common/money"
+	"order-service/constant"
+	orderPaymentDTO "order-service/domain/dto/orderpayment"
+
+	"time"
+)
 
 type OrderRequest struct {
-	CustomerID                 string     `json:"customerID" validate:"required"`
-	CustomerName               string     `json:"customerName"`
-	CustomerEmail              string     `json:"customerEmail"`
-	CustomerPhone              string     `json:"customerPhone"`
-	PackageID                  string     `json:"packageID" validate:"required"`
-	RemainingOutstandingAmount float64    `json:"remainingOutstandingAmount"`
-	OrderID                    string     `json:"orderID"`
-	CompletedAt                *time.Time `json:"completedAt"`
-	IsPaid                     *bool      `json:"isPaid"`
+	CustomerID                 string            `json:"customerID" validate:"required"`
+	CustomerName               string            `json:"customerName"`
+	CustomerEmail              string            `json:"customerEmail"`
+	CustomerPhone              string            `json:"customerPhone"`
+	PackageID                  string            `json:"packageID" validate:"required"`
+	PackagePrice               money.Money       `json:"packagePrice"`
+	Currency                   constant.Currency `json:"currency"`
+	Locale                     locale.Locale     `json:"locale"`
+	PromoID                    *int              `json:"promoID"`
+	PromoName                  *string           `json:"promoName"`
+	DiscountAmount             money.Money       `json:"discountAmount"`
+	VoucherID                  *uint             `json:"voucherID"`
+	VoucherCode                *string           `json:"voucherCode"`
+	VoucherDiscountAmount      money.Money       `json:"voucherDiscountAmount"`
+	TotalAmount                money.Money       `json:"totalAmount"`
+	RemainingOutstandingAmount money.Money       `json:"remainingOutstandingAmount"`
+	InstallmentPlanID          *uint             `json:"installmentPlanID"`
+	OrderID                    string            `json:"orderID"`
+	CompletedAt                *time.Time        `json:"completedAt"`
+	IsPaid                     *bool             `json:"isPaid"`
+}
+
+type OrderResponse struct {
+	OrderID                    uuid.UUID             `json:"orderID"`
+	OrderName                  string                `json:"orderName"`
+	CustomerID                 string                `json:"customerID"`
+	CustomerName               string                `json:"customerName"`
+	CustomerEmail              string                `json:"customerEmail"`
+	CustomerPhone              string                `json:"customerPhone"`
+	PackageID                  string                `json:"packageID"`
+	PackagePrice               money.Money           `json:"packagePrice"`
+	Currency                   constant.Currency     `json:"currency"`
+	Locale                     locale.Locale         `json:"locale"`
+	PromoName                  *string               `json:"promoName"`
+	DiscountAmount             money.Money           `json:"discountAmount"`
+	VoucherCode                *string               `json:"voucherCode"`
+	VoucherDiscountAmount      money.Money           `json:"voucherDiscountAmount"`
+	TotalAmount                money.Money           `json:"totalAmount"`
+	TotalPaid                  money.Money           `json:"totalPaid"`
+	RemainingOutstandingAmount money.Money           `json:"remainingOutstandingAmount"`
+	NextPaymentType            *constant.PaymentType `json:"nextPaymentType"`
+	IsCompleted                bool                  `json:"isCompleted"`
+	CompletedAt                *time.Time            `json:"completedAt"`
+	CreatedAt                  *time.Time            `json:"createdAt"`
+	UpdatedAt                  *time.Time            `json:"updatedAt"`
+	SubOrders                  []SubOrderResponse    `json:"subOrders"`
+}
+
+type SubOrderResponse struct {
+	SubOrderID   uuid.UUID                             `json:"subOrderID"`
+	SubOrderName string                                `json:"subOrderName"`
+	PaymentType  constant.PaymentType                  `json:"paymentType"`
+	Amount       money.Money                           `json:"amount"`
+	Currency     constant.Currency                     `json:"currency"`
+	Status       constant.OrderStatusString            `json:"status"`
+	IsPaid       *bool                                 `json:"isPaid"`
+	OrderDate    time.Time                             `json:"orderDate"`
+	DueDate      *time.Time                            `json:"dueDate,omitempty"`
+	CanceledAt   *time.Time                            `json:"canceledAt,omitempty"`
+	CreatedAt    *time.Time                            `json:"createdAt"`
+	UpdatedAt    *time.Time                            `json:"updatedAt"`
+	Payment      *orderPaymentDTO.OrderPaymentResponse `json:"payment"`
+	Invoice      *InvoiceResponse                      `json:"invoice"`
+	Histories    []HistoryResponse                     `json:"histories"`
+}
+
+type InvoiceResponse struct {
+	InvoiceID     uuid.UUID `json:"invoiceID"`
+	InvoiceNumber string    `json:"invoiceNumber"`
+	InvoiceURL    string    `json:"invoiceURL"`
+}
+
+type HistoryResponse struct {
+	Status    constant.OrderStatusString `json:"status"`
+	CreatedAt *time.Time                 `json:"createdAt"`
 }
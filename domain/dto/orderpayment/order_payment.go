@@ -3,21 +3,24 @@ package dto
 import (
 	"github.com/google/uuid"
 
+	"order-service/common/money"
+	"order-service/constant"
+
 	"time"
 )
 
 type OrderPaymentRequest struct {
-	Amount      float64    `json:"amount"`
-	SubOrderID  uint       `json:"sub_order_id"`
-	PaymentID   uuid.UUID  `json:"payment_id"`
-	PaymentLink string     `json:"payment_link"`
-	Status      *string    `json:"status"`
-	PaymentType *string    `json:"payment_type"`
-	VANumber    *string    `json:"va_number,omitempty"`
-	Bank        *string    `json:"bank,omitempty"`
-	Acquirer    *string    `json:"acquirer,omitempty"`
-	ExpiredAt   *time.Time `json:"expired_at,omitempty"`
-	PaidAt      *time.Time `json:"paid_at,omitempty"`
+	Amount      money.Money `json:"amount"`
+	SubOrderID  uint        `json:"sub_order_id"`
+	PaymentID   uuid.UUID   `json:"payment_id"`
+	PaymentLink string      `json:"payment_link"`
+	Status      *string     `json:"status"`
+	PaymentType *string     `json:"payment_type"`
+	VANumber    *string     `json:"va_number,omitempty"`
+	Bank        *string     `json:"bank,omitempty"`
+	Acquirer    *string     `json:"acquirer,omitempty"`
+	ExpiredAt   *time.Time  `json:"expired_at,omitempty"`
+	PaidAt      *time.Time  `json:"paid_at,omitempty"`
 }
 
 type OrderPaymentResponse struct {
@@ -25,3 +28,12 @@ type OrderPaymentResponse struct {
 	PaymentLink string    `json:"paymentLink"`
 	Status      *string   `json:"status"`
 }
+
+type UnsettledPayment struct {
+	ID             uint
+	PaymentID      uuid.UUID
+	SubOrderUUID   uuid.UUID
+	SubOrderStatus constant.OrderStatus
+	Status         *string
+	Amount         money.Money
+}
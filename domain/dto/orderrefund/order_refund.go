@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	"order-service/constant"
+
+	"time"
+)
+
+type RefundRequest struct {
+	Amount *money.Money `json:"amount" validate:"omitempty,gt=0"`
+	Reason string       `json:"reason" validate:"required,max=255"`
+}
+
+type OrderRefundRequest struct {
+	SubOrderID uint        `json:"subOrderID"`
+	Amount     money.Money `json:"amount"`
+	Reason     string      `json:"reason"`
+	CreatedBy  string      `json:"createdBy"`
+}
+
+type UpdateOrderRefundRequest struct {
+	ID               uint                  `json:"id"`
+	RefundID         *uuid.UUID            `json:"refundID"`
+	Status           constant.RefundStatus `json:"status"`
+	CreditNoteID     *uuid.UUID            `json:"creditNoteID"`
+	CreditNoteNumber *string               `json:"creditNoteNumber"`
+	CreditNoteURL    *string               `json:"creditNoteURL"`
+	RefundedAt       *time.Time            `json:"refundedAt"`
+}
+
+type RefundResponse struct {
+	RefundID      uuid.UUID             `json:"refundID"`
+	SubOrderID    uuid.UUID             `json:"subOrderID"`
+	Amount        money.Money           `json:"amount"`
+	Reason        string                `json:"reason"`
+	Status        constant.RefundStatus `json:"status"`
+	CreditNoteURL *string               `json:"creditNoteURL"`
+	RefundedAt    *time.Time            `json:"refundedAt"`
+	CreatedAt     *time.Time            `json:"createdAt"`
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	invoiceClient "order-service/clients/invoice"
+	notificationClient "order-service/clients/notification"
+	paymentClient "order-service/clients/payment"
+	"order-service/common/locale"
+	"order-service/constant"
+
+	"time"
+)
+
+type OutboxRequest struct {
+	SubOrderID     uint                 `json:"subOrderID"`
+	Event          constant.OutboxEvent `json:"event"`
+	IdempotencyKey string               `json:"idempotencyKey"`
+	Payload        any                  `json:"payload"`
+}
+
+type UpdateOutboxRequest struct {
+	ID            uint                  `json:"id"`
+	Status        constant.OutboxStatus `json:"status"`
+	Attempts      int                   `json:"attempts"`
+	NextAttemptAt time.Time             `json:"nextAttemptAt"`
+	LastError     *string               `json:"lastError"`
+	ProcessedAt   *time.Time            `json:"processedAt"`
+}
+
+type PaymentLinkPayload struct {
+	SubOrderName string                       `json:"subOrderName"`
+	Description  string                       `json:"description"`
+	Locale       locale.Locale                `json:"locale"`
+	Payment      paymentClient.PaymentRequest `json:"payment"`
+}
+
+type ExpirePaymentLinkPayload struct {
+	PaymentID uuid.UUID `json:"paymentID"`
+}
+
+type InvoicePayload struct {
+	Invoice invoiceClient.InvoiceRequest `json:"invoice"`
+}
+
+type NotificationPayload struct {
+	Notification notificationClient.NotificationRequest `json:"notification"`
+}
+
+type EventPayload struct {
+	Topic   string          `json:"topic"`
+	Key     string          `json:"key"`
+	Message json.RawMessage `json:"message"`
+}
+
+type RefundPayload struct {
+	RefundID  uuid.UUID                   `json:"refundID"`
+	PaymentID uuid.UUID                   `json:"paymentID"`
+	Refund    paymentClient.RefundRequest `json:"refund"`
+}
+
+type CreditNotePayload struct {
+	RefundID uuid.UUID                    `json:"refundID"`
+	Invoice  invoiceClient.InvoiceRequest `json:"invoice"`
+}
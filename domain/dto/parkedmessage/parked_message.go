@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/constant"
+
+	"time"
+)
+
+type ParkedMessageRequest struct {
+	Topic            string            `json:"topic"`
+	Partition        int32             `json:"partition"`
+	Offset           int64             `json:"offset"`
+	Key              *string           `json:"key"`
+	Value            string            `json:"value"`
+	Headers          map[string]string `json:"headers"`
+	Error            string            `json:"error"`
+	RetryCount       int               `json:"retryCount"`
+	MessageTimestamp time.Time         `json:"messageTimestamp"`
+	FailedAt         time.Time         `json:"failedAt"`
+}
+
+type UpdateParkedMessageRequest struct {
+	ID              uint                         `json:"id"`
+	Status          constant.ParkedMessageStatus `json:"status"`
+	ReplayCount     int                          `json:"replayCount"`
+	LastReplayError *string                      `json:"lastReplayError"`
+	ReplayedAt      *time.Time                   `json:"replayedAt"`
+}
+
+type ParkedMessageRequestParam struct {
+	Page   int                          `form:"page" validate:"required"`
+	Limit  int                          `form:"limit" validate:"required"`
+	Topic  string                       `form:"topic"`
+	Status constant.ParkedMessageStatus `form:"status" validate:"omitempty,oneof=parked replayed"`
+}
+
+type ParkedMessageResponse struct {
+	UUID             uuid.UUID                    `json:"uuid"`
+	Topic            string                       `json:"topic"`
+	Partition        int32                        `json:"partition"`
+	Offset           int64                        `json:"offset"`
+	Key              *string                      `json:"key"`
+	Value            string                       `json:"value"`
+	Headers          map[string]string            `json:"headers"`
+	Error            string                       `json:"error"`
+	RetryCount       int                          `json:"retryCount"`
+	Status           constant.ParkedMessageStatus `json:"status"`
+	ReplayCount      int                          `json:"replayCount"`
+	LastReplayError  *string                      `json:"lastReplayError"`
+	MessageTimestamp time.Time                    `json:"messageTimestamp"`
+	FailedAt         time.Time                    `json:"failedAt"`
+	ReplayedAt       *time.Time                   `json:"replayedAt"`
+	CreatedAt        *time.Time                   `json:"createdAt"`
+}
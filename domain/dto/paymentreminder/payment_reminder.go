@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/locale"
+	"order-service/common/money"
+	"order-service/constant"
+
+	"time"
+)
+
+type PaymentReminderRequest struct {
+	SubOrderID     uint
+	PaymentID      uuid.UUID
+	OffsetInMinute int
+}
+
+type DuePaymentReminder struct {
+	SubOrderID        uint
+	SubOrderName      string
+	PaymentType       constant.PaymentType
+	PaymentID         uuid.UUID
+	PaymentURL        *string
+	Amount            money.Money
+	Currency          constant.Currency
+	Locale            locale.Locale
+	ExpiredAt         time.Time
+	CustomerPhone     string
+	InstallmentPlanID *uint
+}
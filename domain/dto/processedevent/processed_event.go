@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+type ProcessedEventRequest struct {
+	EventKey  string    `json:"eventKey"`
+	Event     string    `json:"event"`
+	ExpiredAt time.Time `json:"expiredAt"`
+}
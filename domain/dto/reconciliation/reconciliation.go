@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	"order-service/constant"
+
+	"time"
+)
+
+type ReconciliationRequest struct {
+	BatchSize int
+	DryRun    bool
+}
+
+type ReconciliationReport struct {
+	StartedAt  time.Time            `json:"startedAt"`
+	FinishedAt time.Time            `json:"finishedAt"`
+	DryRun     bool                 `json:"dryRun"`
+	Checked    int                  `json:"checked"`
+	Mismatched int                  `json:"mismatched"`
+	Applied    int                  `json:"applied"`
+	Failed     int                  `json:"failed"`
+	Items      []ReconciliationItem `json:"items"`
+}
+
+type ReconciliationItem struct {
+	PaymentID      uuid.UUID                     `json:"paymentID"`
+	SubOrderID     uuid.UUID                     `json:"subOrderID"`
+	SubOrderStatus constant.OrderStatus          `json:"subOrderStatus"`
+	Amount         money.Money                   `json:"amount"`
+	LocalStatus    string                        `json:"localStatus"`
+	RemoteStatus   string                        `json:"remoteStatus"`
+	Action         constant.ReconciliationAction `json:"action"`
+	Error          string                        `json:"error,omitempty"`
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"github.com/google/uuid"
 
+	"order-service/common/money"
 	"order-service/constant"
 	orderPaymentDTO "order-service/domain/dto/orderpayment"
 
@@ -10,14 +11,15 @@ import (
 )
 
 type SubOrderRequest struct {
-	OrderID     uuid.UUID            `json:"orderID" validate:"required_unless=PaymentType down_payment"`
+	OrderID     uuid.UUID            `json:"orderID"`
 	CustomerID  uuid.UUID            `json:"customerID" validate:"required"`
 	PackageID   uuid.UUID            `json:"packageID" validate:"required"`
-	Amount      float64              `json:"amount" validate:"required"`
+	Amount      money.Money          `json:"amount" validate:"required"`
 	OrderDate   time.Time            `json:"orderDate" validate:"required"`
 	Status      constant.OrderStatus `json:"status"`
 	IsPaid      *bool                `json:"isPaid"`
-	PaymentType constant.PaymentType `json:"paymentType" validate:"required,oneof=down_payment half_payment full_payment"`
+	PaymentType constant.PaymentType `json:"paymentType" validate:"required,max=30"`
+	VoucherCode string               `json:"voucherCode" validate:"omitempty,max=50"`
 	CanceledAt  *time.Time           `json:"canceledAt"`
 }
 
@@ -29,27 +31,44 @@ type UpdateSubOrderRequest struct {
 }
 
 type PaymentRequest struct {
-	OrderID     uuid.UUID  `json:"order_id"`
-	PaymentID   uuid.UUID  `json:"payment_id"`
-	PaymentLink string     `json:"payment_link"`
-	PaymentType string     `json:"payment_type"`
-	Amount      float64    `json:"amount"`
-	Status      string     `json:"status"`
-	VaNumber    *string    `json:"va_number"`
-	Bank        *string    `json:"bank"`
-	Acquirer    *string    `json:"acquirer"`
-	ExpiredAt   *time.Time `json:"expired_at"`
-	PaidAt      *time.Time `json:"paid_at"`
+	MessageID   *string     `json:"message_id"`
+	Event       string      `json:"event"`
+	OrderID     uuid.UUID   `json:"order_id"`
+	PaymentID   uuid.UUID   `json:"payment_id"`
+	PaymentLink string      `json:"payment_link"`
+	PaymentType string      `json:"payment_type"`
+	Amount      money.Money `json:"amount"`
+	Status      string      `json:"status"`
+	VaNumber    *string     `json:"va_number"`
+	Bank        *string     `json:"bank"`
+	Acquirer    *string     `json:"acquirer"`
+	ExpiredAt   *time.Time  `json:"expired_at"`
+	PaidAt      *time.Time  `json:"paid_at"`
 }
 
 type CancelRequest struct {
-	UUID   uuid.UUID            `json:"uuid,omitempty"`
-	Status constant.OrderStatus `json:"status"`
+	UUID       uuid.UUID            `json:"uuid,omitempty"`
+	CustomerID string               `json:"customerID,omitempty"`
+	Status     constant.OrderStatus `json:"status"`
 }
 
 type SubOrderRequestParam struct {
-	Page  int `form:"page" validate:"required"`
-	Limit int `form:"limit" validate:"required"`
+	Page           int                        `form:"page" validate:"required_unless=PaginationMode cursor"`
+	PaginationMode string                     `form:"paginationMode" validate:"omitempty,oneof=offset cursor"`
+	Cursor         string                     `form:"cursor" validate:"omitempty,max=256"`
+	Limit          int                        `form:"limit" validate:"required"`
+	Status         constant.OrderStatusString `form:"status" validate:"omitempty,oneof=pending pending-payment payment-success cancelled refunded partially-refunded"` //nolint:lll
+	PaymentType    constant.PaymentType       `form:"paymentType" validate:"omitempty,max=30"`
+	CustomerID     string                     `form:"customerID" validate:"omitempty,uuid"`
+	PackageID      string                     `form:"packageID" validate:"omitempty,uuid"`
+	IsPaid         *bool                      `form:"isPaid"`
+	OrderDateFrom  time.Time                  `form:"orderDateFrom" time_format:"2006-01-02"`
+	OrderDateTo    time.Time                  `form:"orderDateTo" time_format:"2006-01-02"`
+	CreatedFrom    time.Time                  `form:"createdFrom" time_format:"2006-01-02"`
+	CreatedTo      time.Time                  `form:"createdTo" time_format:"2006-01-02"`
+	Search         string                     `form:"search" validate:"omitempty,max=100"`
+	SortBy         string                     `form:"sortBy" validate:"excluded_if=PaginationMode cursor,omitempty,oneof=createdAt updatedAt orderDate amount subOrderName status"` //nolint:lll
+	SortDirection  string                     `form:"sortDirection" validate:"excluded_if=PaginationMode cursor,omitempty,oneof=asc desc"`
 }
 
 type SubOrderResponse struct {
@@ -58,9 +77,11 @@ type SubOrderResponse struct {
 	SubOrderName string                                `json:"subOrderName"`
 	CustomerID   string                                `json:"customerID"`
 	PackageID    string                                `json:"packageID"`
-	Amount       float64                               `json:"amount"`
+	Amount       money.Money                           `json:"amount"`
+	Currency     constant.Currency                     `json:"currency"`
 	Status       constant.OrderStatus                  `json:"status"`
 	OrderDate    time.Time                             `json:"orderDate,omitempty"`
+	DueDate      *time.Time                            `json:"dueDate,omitempty"`
 	IsPaid       *bool                                 `json:"isPaid"`
 	CanceledAt   *time.Time                            `json:"canceledAt,omitempty"`
 	CreatedAt    *time.Time                            `json:"createdAt"`
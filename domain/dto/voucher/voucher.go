@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	"order-service/constant"
+
+	"time"
+)
+
+type VoucherRequest struct {
+	Code                  string                       `json:"code" validate:"required,max=50,alphanum"`
+	Name                  string                       `json:"name" validate:"required,max=100"`
+	Description           string                       `json:"description" validate:"max=255"`
+	DiscountType          constant.VoucherDiscountType `json:"discountType" validate:"required,oneof=percentage fixed"`
+	Value                 float64                      `json:"value" validate:"required_if=DiscountType percentage,excluded_if=DiscountType fixed,omitempty,gt=0,lte=100"` //nolint:lll
+	Amount                money.Money                  `json:"amount" validate:"required_if=DiscountType fixed,excluded_if=DiscountType percentage,omitempty,gt=0"`        //nolint:lll
+	MaxDiscountAmount     *money.Money                 `json:"maxDiscountAmount" validate:"omitempty,gt=0"`
+	UsageLimit            *int                         `json:"usageLimit" validate:"omitempty,gt=0"`
+	UsageLimitPerCustomer *int                         `json:"usageLimitPerCustomer" validate:"omitempty,gt=0"`
+	StartDate             time.Time                    `json:"startDate" validate:"required"`
+	EndDate               time.Time                    `json:"endDate" validate:"required,gtfield=StartDate"`
+	IsActive              bool                         `json:"isActive"`
+	PackageIDs            []uuid.UUID                  `json:"packageIDs"`
+}
+
+type VoucherRequestParam struct {
+	Page   int    `form:"page" validate:"required"`
+	Limit  int    `form:"limit" validate:"required"`
+	Search string `form:"search" validate:"omitempty,max=100"`
+}
+
+type ApplyVoucherRequest struct {
+	Code       string
+	CustomerID string
+	PackageID  string
+	Amount     money.Money
+	Currency   constant.Currency
+}
+
+type RedeemVoucherRequest struct {
+	VoucherID      uint
+	OrderID        uint
+	CustomerID     string
+	DiscountAmount money.Money
+}
+
+type VoucherResponse struct {
+	UUID                  uuid.UUID                    `json:"uuid"`
+	Code                  string                       `json:"code"`
+	Name                  string                       `json:"name"`
+	Description           string                       `json:"description"`
+	DiscountType          constant.VoucherDiscountType `json:"discountType"`
+	Value                 float64                      `json:"value"`
+	Amount                money.Money                  `json:"amount"`
+	MaxDiscountAmount     *money.Money                 `json:"maxDiscountAmount"`
+	UsageLimit            *int                         `json:"usageLimit"`
+	UsageLimitPerCustomer *int                         `json:"usageLimitPerCustomer"`
+	UsedCount             int                          `json:"usedCount"`
+	StartDate             time.Time                    `json:"startDate"`
+	EndDate               time.Time                    `json:"endDate"`
+	IsActive              bool                         `json:"isActive"`
+	PackageIDs            []string                     `json:"packageIDs"`
+	CreatedAt             *time.Time                   `json:"createdAt"`
+	UpdatedAt             *time.Time                   `json:"updatedAt"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"order-service/common/money"
+	"order-service/constant"
+	"time"
+)
+
+type InstallmentPlan struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:varchar(36);unique;not null"`
+	Name        string    `gorm:"type:varchar(100);unique;not null"`
+	Description string    `gorm:"type:varchar(255)"`
+	IsDefault   bool      `gorm:"not null;default:false"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	DeletedAt   *gorm.DeletedAt
+	Items       []InstallmentPlanItem `gorm:"foreignKey:installment_plan_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"` //nolint:lll
+}
+
+type InstallmentPlanItem struct {
+	ID                 uint                           `gorm:"primaryKey;autoIncrement"`
+	InstallmentPlanID  uint                           `gorm:"not null;uniqueIndex:idx_installment_plan_items_sequence"`
+	Sequence           int                            `gorm:"not null;uniqueIndex:idx_installment_plan_items_sequence"`
+	PaymentType        constant.PaymentType           `gorm:"type:varchar(30);not null"`
+	Title              string                         `gorm:"type:varchar(100);not null"`
+	IndonesianTitle    string                         `gorm:"type:varchar(100);not null"`
+	AmountType         constant.InstallmentAmountType `gorm:"type:varchar(30);not null"`
+	Value              float64                        `gorm:"type:numeric(15,2);not null;default:0"`
+	Amount             money.Money                    `gorm:"type:numeric(15,2);not null;default:0"`
+	IsOptional         bool                           `gorm:"not null;default:false"`
+	DueDateOffsetInDay *int
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+}
+
+type PackageInstallmentPlan struct {
+	ID                uint   `gorm:"primaryKey;autoIncrement"`
+	PackageID         string `gorm:"type:varchar(36);unique;not null"`
+	InstallmentPlanID uint   `gorm:"not null"`
+	CreatedAt         *time.Time
+	UpdatedAt         *time.Time
+	InstallmentPlan   InstallmentPlan `gorm:"foreignKey:installment_plan_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"` //nolint:lll
+}
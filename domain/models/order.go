@@ -6,18 +6,33 @@ import (
 	"gorm.io/gorm"
 
 	"time"
+
+	"order-service/common/locale"
+	"order-service/common/money"
+	"order-service/constant"
 )
 
 type Order struct {
-	ID                         uint      `gorm:"primaryKey;autoIncrement"`
-	UUID                       uuid.UUID `gorm:"type:varchar(36);unique;not null"`
-	OrderName                  string    `gorm:"type:varchar(20);unique;not null"`
-	CustomerID                 string    `gorm:"type:varchar(36);not null"`
-	CustomerName               string    `gorm:"type:varchar(100);not null"`
-	CustomerEmail              string    `gorm:"type:varchar(70);not null"`
-	CustomerPhone              string    `gorm:"type:varchar(20);not null"`
-	PackageID                  string    `gorm:"type:varchar(36);not null"`
-	RemainingOutstandingAmount float64   `gorm:"null;type:numeric(15,2)"`
+	ID                         uint              `gorm:"primaryKey;autoIncrement"`
+	UUID                       uuid.UUID         `gorm:"type:varchar(36);unique;not null"`
+	OrderName                  string            `gorm:"type:varchar(20);unique;not null"`
+	CustomerID                 string            `gorm:"type:varchar(36);not null"`
+	CustomerName               string            `gorm:"type:varchar(100);not null"`
+	CustomerEmail              string            `gorm:"type:varchar(70);not null"`
+	CustomerPhone              string            `gorm:"type:varchar(20);not null"`
+	PackageID                  string            `gorm:"type:varchar(36);not null"`
+	PackagePrice               money.Money       `gorm:"null;type:numeric(15,2)"`
+	Currency                   constant.Currency `gorm:"type:varchar(3);not null;default:'IDR'"`
+	Locale                     locale.Locale     `gorm:"type:varchar(5);not null;default:'id'"`
+	PromoID                    *int
+	PromoName                  *string     `gorm:"type:varchar(100)"`
+	DiscountAmount             money.Money `gorm:"not null;default:0;type:numeric(15,2)"`
+	VoucherID                  *uint
+	VoucherCode                *string     `gorm:"type:varchar(50)"`
+	VoucherDiscountAmount      money.Money `gorm:"not null;default:0;type:numeric(15,2)"`
+	TotalAmount                money.Money `gorm:"null;type:numeric(15,2)"`
+	RemainingOutstandingAmount money.Money `gorm:"null;type:numeric(15,2)"`
+	InstallmentPlanID          *uint
 	CompletedAt                *time.Time
 	CreatedAt                  *time.Time
 	UpdatedAt                  *time.Time
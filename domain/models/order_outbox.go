@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/constant"
+	"time"
+)
+
+type OrderOutbox struct {
+	ID             uint                  `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID             `gorm:"type:varchar(36);unique;not null"`
+	SubOrderID     uint                  `gorm:"not null;index"`
+	Event          constant.OutboxEvent  `gorm:"type:varchar(50);not null"`
+	IdempotencyKey string                `gorm:"type:varchar(100);unique;not null"`
+	Payload        string                `gorm:"type:text;not null"`
+	Status         constant.OutboxStatus `gorm:"type:varchar(20);not null;index"`
+	Attempts       int                   `gorm:"not null;default:0"`
+	LastError      *string               `gorm:"type:text"`
+	NextAttemptAt  time.Time             `gorm:"not null;index"`
+	ProcessedAt    *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (OrderOutbox) TableName() string {
+	return "order_outbox"
+}
@@ -4,21 +4,24 @@ import (
 	"github.com/google/uuid"
 
 	"time"
+
+	"order-service/common/money"
 )
 
 type OrderPayment struct {
-	ID          uint `gorm:"primaryKey;autoIncrement"`
-	Amount      float64
-	SubOrderID  uint
-	PaymentID   uuid.UUID
-	PaymentURL  *string
-	Status      *string
-	PaidAt      *time.Time
-	ExpiredAt   *time.Time
-	PaymentType *string `gorm:"null"`
-	VANumber    *string `gorm:"null"`
-	Bank        *string `gorm:"null"`
-	Acquirer    *string `gorm:"null"`
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID           uint        `gorm:"primaryKey;autoIncrement"`
+	Amount       money.Money `gorm:"type:numeric(15,2)"`
+	SubOrderID   uint
+	PaymentID    uuid.UUID
+	PaymentURL   *string
+	Status       *string
+	PaidAt       *time.Time
+	ExpiredAt    *time.Time
+	SupersededAt *time.Time
+	PaymentType  *string `gorm:"null"`
+	VANumber     *string `gorm:"null"`
+	Bank         *string `gorm:"null"`
+	Acquirer     *string `gorm:"null"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
 }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/common/money"
+	"order-service/constant"
+	"time"
+)
+
+type OrderRefund struct {
+	ID               uint                  `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID             `gorm:"type:varchar(36);unique;not null"`
+	SubOrderID       uint                  `gorm:"not null;index"`
+	RefundID         *uuid.UUID            `gorm:"type:varchar(36)"`
+	Amount           money.Money           `gorm:"not null;type:numeric(15,2)"`
+	Reason           string                `gorm:"type:varchar(255);not null"`
+	Status           constant.RefundStatus `gorm:"type:varchar(20);not null"`
+	CreditNoteID     *uuid.UUID            `gorm:"type:varchar(36)"`
+	CreditNoteNumber *string               `gorm:"type:varchar(50)"`
+	CreditNoteURL    *string
+	CreatedBy        string `gorm:"type:varchar(36);not null"`
+	RefundedAt       *time.Time
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	SubOrder         SubOrder `gorm:"foreignKey:sub_order_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
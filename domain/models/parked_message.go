@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"order-service/constant"
+	"time"
+)
+
+type ParkedMessage struct {
+	ID               uint                         `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID                    `gorm:"type:varchar(36);unique;not null"`
+	Topic            string                       `gorm:"type:varchar(100);not null;index;uniqueIndex:idx_parked_messages_message"` //nolint:lll
+	Partition        int32                        `gorm:"not null;uniqueIndex:idx_parked_messages_message"`
+	Offset           int64                        `gorm:"not null;uniqueIndex:idx_parked_messages_message"`
+	Key              *string                      `gorm:"type:text"`
+	Value            string                       `gorm:"type:text;not null"`
+	Headers          string                       `gorm:"type:text;not null"`
+	Error            string                       `gorm:"type:text;not null"`
+	RetryCount       int                          `gorm:"not null;default:0"`
+	Status           constant.ParkedMessageStatus `gorm:"type:varchar(20);not null;index"`
+	ReplayCount      int                          `gorm:"not null;default:0"`
+	LastReplayError  *string                      `gorm:"type:text"`
+	MessageTimestamp time.Time                    `gorm:"not null"`
+	FailedAt         time.Time                    `gorm:"not null"`
+	ReplayedAt       *time.Time
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+}
+
+func (ParkedMessage) TableName() string {
+	return "parked_messages"
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"time"
+)
+
+type PaymentReminder struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	SubOrderID     uint      `gorm:"not null"`
+	PaymentID      uuid.UUID `gorm:"not null;uniqueIndex:idx_payment_reminders_offset"`
+	OffsetInMinute int       `gorm:"not null;uniqueIndex:idx_payment_reminders_offset"`
+	SentAt         time.Time `gorm:"not null"`
+}
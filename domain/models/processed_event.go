@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+type ProcessedEvent struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	EventKey    string    `gorm:"type:varchar(150);unique;not null"`
+	Event       string    `gorm:"type:varchar(50);not null"`
+	ProcessedAt time.Time `gorm:"not null"`
+	ExpiredAt   time.Time `gorm:"not null;index"`
+}
+
+func (ProcessedEvent) TableName() string {
+	return "processed_events"
+}
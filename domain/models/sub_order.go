@@ -4,25 +4,28 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"order-service/common/money"
 	"order-service/constant"
 	"time"
 )
 
 type SubOrder struct {
-	ID           uint                 `gorm:"primaryKey;autoIncrement"`
+	ID           uint                 `gorm:"primaryKey;autoIncrement;index:idx_sub_orders_created_at_id,priority:2"`
 	UUID         uuid.UUID            `gorm:"type:varchar(36);unique;not null"`
 	OrderID      uint                 `gorm:"not null"`
 	SubOrderName string               `gorm:"type:varchar(25);unique;not null"`
-	Amount       float64              `gorm:"not null"`
+	Amount       money.Money          `gorm:"type:numeric(15,2);not null"`
+	Currency     constant.Currency    `gorm:"type:varchar(3);not null;default:'IDR'"`
 	Status       constant.OrderStatus `gorm:"not null"`
 	IsPaid       *bool                `gorm:"not null"`
 	OrderDate    time.Time            `gorm:"not null"`
+	DueDate      *time.Time
 	CanceledAt   *time.Time
 	PaymentType  constant.PaymentType
 	Order        Order          `gorm:"foreignKey:order_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Payment      OrderPayment   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Histories    []OrderHistory `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	CreatedAt    *time.Time
+	CreatedAt    *time.Time     `gorm:"index:idx_sub_orders_created_at_id,priority:1"`
 	UpdatedAt    *time.Time
 	DeletedAt    *gorm.DeletedAt
 }
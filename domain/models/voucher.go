@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"order-service/common/money"
+	"order-service/constant"
+	"time"
+)
+
+type Voucher struct {
+	ID                    uint                         `gorm:"primaryKey;autoIncrement"`
+	UUID                  uuid.UUID                    `gorm:"type:varchar(36);unique;not null"`
+	Code                  string                       `gorm:"type:varchar(50);unique;not null"`
+	Name                  string                       `gorm:"type:varchar(100);not null"`
+	Description           string                       `gorm:"type:varchar(255)"`
+	DiscountType          constant.VoucherDiscountType `gorm:"type:varchar(20);not null"`
+	Value                 float64                      `gorm:"type:numeric(15,2);not null"`
+	Amount                money.Money                  `gorm:"type:numeric(15,2);not null;default:0"`
+	MaxDiscountAmount     *money.Money                 `gorm:"type:numeric(15,2)"`
+	UsageLimit            *int
+	UsageLimitPerCustomer *int
+	UsedCount             int       `gorm:"not null;default:0"`
+	StartDate             time.Time `gorm:"not null"`
+	EndDate               time.Time `gorm:"not null"`
+	IsActive              bool      `gorm:"not null;default:true"`
+	CreatedAt             *time.Time
+	UpdatedAt             *time.Time
+	DeletedAt             *gorm.DeletedAt
+	Packages              []VoucherPackage `gorm:"foreignKey:voucher_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"` //nolint:lll
+}
+
+type VoucherPackage struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	VoucherID uint   `gorm:"not null;uniqueIndex:idx_voucher_packages_package"`
+	PackageID string `gorm:"type:varchar(36);not null;uniqueIndex:idx_voucher_packages_package"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+type VoucherRedemption struct {
+	ID             uint        `gorm:"primaryKey;autoIncrement"`
+	VoucherID      uint        `gorm:"not null;index:idx_voucher_redemptions_customer"`
+	CustomerID     string      `gorm:"type:varchar(36);not null;index:idx_voucher_redemptions_customer"`
+	OrderID        uint        `gorm:"not null;unique"`
+	DiscountAmount money.Money `gorm:"type:numeric(15,2);not null"`
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
@@ -13,7 +15,10 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	clientConfig "order-service/clients/config"
+	"order-service/common/locale"
 
+	"order-service/common/metrics"
+	"order-service/common/sentry"
 	"order-service/config"
 	"order-service/constant"
 	constantError "order-service/constant/error"
@@ -49,7 +54,7 @@ func ValidateAPIKey() gin.HandlerFunc {
 		if apiKey != apiKeyHash {
 			c.JSON(http.StatusUnauthorized, response.Response{
 				Status:  constantError.Error,
-				Message: constantError.ErrUnauthorized.Error(),
+				Message: response.ErrorMessage(c.Request.Context(), constantError.ErrUnauthorized),
 			})
 			c.Abort()
 			return
@@ -64,7 +69,7 @@ func AuthenticateRBAC() gin.HandlerFunc {
 		if token == "" {
 			c.JSON(http.StatusUnauthorized, response.Response{
 				Status:  constantError.Error,
-				Message: constantError.ErrUnauthorized.Error(),
+				Message: response.ErrorMessage(c.Request.Context(), constantError.ErrUnauthorized),
 			})
 			c.Abort()
 			return
@@ -85,7 +90,12 @@ func AuthenticateRBAC() gin.HandlerFunc {
 			return
 		}
 
-		userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), constant.UserLogin, user)) //nolint:staticcheck,lll
+		ctx := context.WithValue(c.Request.Context(), constant.UserLogin, user) //nolint:staticcheck
+		if _, ok := locale.Requested(ctx); !ok && user.Locale != "" {
+			ctx = locale.WithLocale(ctx, locale.Parse(user.Locale))
+		}
+
+		userLogin := c.Request.WithContext(ctx)
 		c.Request = userLogin
 		c.Set(constant.Token, token)
 		c.Next()
@@ -98,7 +108,7 @@ func CheckPermission(permissions []string) gin.HandlerFunc {
 		if !ok {
 			c.JSON(http.StatusUnauthorized, response.Response{
 				Status:  constantError.Error,
-				Message: constantError.ErrUnauthorized.Error(),
+				Message: response.ErrorMessage(c.Request.Context(), constantError.ErrUnauthorized),
 			})
 			c.Abort()
 			return
@@ -122,7 +132,7 @@ func CheckPermission(permissions []string) gin.HandlerFunc {
 		if !user.Allowed {
 			c.JSON(http.StatusForbidden, response.Response{
 				Status:  constantError.Error,
-				Message: constantError.ErrForbidden.Error(),
+				Message: response.ErrorMessage(c.Request.Context(), constantError.ErrForbidden),
 			})
 			c.Abort()
 			return
@@ -138,7 +148,7 @@ func RateLimiter(lmt *limiter.Limiter) gin.HandlerFunc {
 		if err != nil {
 			c.JSON(http.StatusTooManyRequests, response.Response{
 				Status:  constantError.Error,
-				Message: constantError.ErrTooManyRequest.Error(),
+				Message: response.ErrorMessage(c.Request.Context(), constantError.ErrTooManyRequest),
 			})
 			c.Abort()
 			return
@@ -146,3 +156,51 @@ func RateLimiter(lmt *limiter.Limiter) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// Localize reads the locale requested through the Accept-Language header, requests without the
+// header fall back to the locale of the customer profile.
+func Localize() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if language := c.GetHeader(constant.AcceptLanguage); language != "" {
+			c.Request = c.Request.WithContext(locale.WithLocale(c.Request.Context(), locale.Parse(language)))
+		}
+		c.Next()
+	}
+}
+
+// Metrics records the latency and status of every request under its route template, requests
+// that do not match a route share a single label to keep the cardinality bounded.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTP(c.Request.Method, route, c.Writer.Status(), start)
+	}
+}
+
+// Trace continues the trace sent by the caller and exposes the request span to the handlers
+// through the request context.
+func Trace(tracer sentry.ISentry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		headers := make(map[string]string, len(c.Request.Header))
+		for key := range c.Request.Header {
+			headers[strings.ToLower(key)] = c.Request.Header.Get(key)
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		ctx := tracer.Extract(c.Request.Context(), headers)
+		span := tracer.StartSpan(ctx, fmt.Sprintf("%s %s", c.Request.Method, route))
+		defer tracer.Finish(span)
+
+		c.Request = c.Request.WithContext(tracer.SpanContext(span))
+		c.Next()
+	}
+}
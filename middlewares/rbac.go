@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	clientConfig "order-service/clients/config"
+	"order-service/common/metrics"
 	"order-service/config"
 	"order-service/constant"
 	"order-service/utils/helper"
@@ -36,6 +37,7 @@ type RBACData struct {
 	Email       string    `json:"email"`
 	Username    string    `json:"username"`
 	PhoneNumber string    `json:"phone_number"`
+	Locale      string    `json:"locale"`
 	Roles       []Entity  `json:"roles"`
 	Permissions []Entity  `json:"permissions"`
 }
@@ -84,7 +86,9 @@ func (m *IRBACMiddleware) GetUserLogin(token string) (*RBACData, error) {
 		Set(constant.Authorization, token).
 		Get(fmt.Sprintf("%s/api/v1/user/login", m.client.BaseURL()))
 
+	start := time.Now()
 	resp, _, errs := clone.EndStruct(&response)
+	metrics.ObserveClient("rbac", "get_user_login", start, resp, errs)
 	if len(errs) > 0 {
 		return nil, errs[0]
 	}
@@ -114,6 +118,7 @@ func (m *IRBACMiddleware) CheckPermission(token string, permissions []string) (*
 	}
 
 	clone := m.client.Client().Clone()
+	start := time.Now()
 	resp, bodyResp, errs := clone.
 		Post(fmt.Sprintf("%s/api/v1/permission/check", m.client.BaseURL())).
 		Set(constant.XServiceName, config.Config.AppName).
@@ -122,6 +127,7 @@ func (m *IRBACMiddleware) CheckPermission(token string, permissions []string) (*
 		Set(constant.Authorization, token).
 		Send(string(body)).
 		End()
+	metrics.ObserveClient("rbac", "check_permission", start, resp, errs)
 
 	var errResponse ErrorRBACResponse
 	if resp.StatusCode != http.StatusOK || len(errs) > 0 {
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS sub_orders ALTER COLUMN amount TYPE numeric(15,2) USING round(amount::numeric, 2);
+ALTER TABLE IF EXISTS order_payments ALTER COLUMN amount TYPE numeric(15,2) USING round(amount::numeric, 2);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_parked_messages_message;
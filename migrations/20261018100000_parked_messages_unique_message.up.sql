@@ -0,0 +1,14 @@
+DO $$
+BEGIN
+    IF to_regclass('parked_messages') IS NOT NULL THEN
+        DELETE FROM parked_messages duplicate
+        USING parked_messages original
+        WHERE duplicate.topic = original.topic
+          AND duplicate."partition" = original."partition"
+          AND duplicate."offset" = original."offset"
+          AND duplicate.id > original.id;
+
+        CREATE UNIQUE INDEX IF NOT EXISTS idx_parked_messages_message
+            ON parked_messages (topic, "partition", "offset");
+    END IF;
+END $$;
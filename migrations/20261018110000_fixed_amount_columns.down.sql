@@ -0,0 +1,12 @@
+DO $$
+BEGIN
+    IF to_regclass('installment_plan_items') IS NOT NULL THEN
+        UPDATE installment_plan_items SET value = amount WHERE amount_type = 'fixed';
+        ALTER TABLE installment_plan_items DROP COLUMN IF EXISTS amount;
+    END IF;
+
+    IF to_regclass('vouchers') IS NOT NULL THEN
+        UPDATE vouchers SET value = amount WHERE discount_type = 'fixed';
+        ALTER TABLE vouchers DROP COLUMN IF EXISTS amount;
+    END IF;
+END $$;
@@ -0,0 +1,12 @@
+DO $$
+BEGIN
+    IF to_regclass('installment_plan_items') IS NOT NULL THEN
+        ALTER TABLE installment_plan_items ADD COLUMN IF NOT EXISTS amount numeric(15,2) NOT NULL DEFAULT 0;
+        UPDATE installment_plan_items SET amount = round(value::numeric, 2), value = 0 WHERE amount_type = 'fixed';
+    END IF;
+
+    IF to_regclass('vouchers') IS NOT NULL THEN
+        ALTER TABLE vouchers ADD COLUMN IF NOT EXISTS amount numeric(15,2) NOT NULL DEFAULT 0;
+        UPDATE vouchers SET amount = round(value::numeric, 2), value = 0 WHERE discount_type = 'fixed';
+    END IF;
+END $$;
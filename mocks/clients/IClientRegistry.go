@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	notification "order-service/clients/notification"
+
 	payment "order-service/clients/payment"
 
 	weddingpackage "order-service/clients/weddingpackage"
@@ -33,6 +35,22 @@ func (_m *IClientRegistry) GetInvoice() clients.IInvoiceClient {
 	return r0
 }
 
+// GetNotification provides a mock function with given fields:
+func (_m *IClientRegistry) GetNotification() notification.INotificationClient {
+	ret := _m.Called()
+
+	var r0 notification.INotificationClient
+	if rf, ok := ret.Get(0).(func() notification.INotificationClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.INotificationClient)
+		}
+	}
+
+	return r0
+}
+
 // GetPayment provides a mock function with given fields:
 func (_m *IClientRegistry) GetPayment() payment.IPaymentClient {
 	ret := _m.Called()
@@ -0,0 +1,43 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	clients "order-service/clients/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// INotificationClient is an autogenerated mock type for the INotificationClient type
+type INotificationClient struct {
+	mock.Mock
+}
+
+// SendToWhatsapp provides a mock function with given fields: _a0, _a1
+func (_m *INotificationClient) SendToWhatsapp(_a0 context.Context, _a1 *clients.NotificationRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.NotificationRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewINotificationClient creates a new instance of INotificationClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewINotificationClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *INotificationClient {
+	mock := &INotificationClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 	clients "order-service/clients/payment"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // IPaymentClient is an autogenerated mock type for the IPaymentClient type
@@ -40,6 +42,72 @@ func (_m *IPaymentClient) CreatePaymentLink(_a0 context.Context, _a1 *clients.Pa
 	return r0, r1
 }
 
+// ExpirePayment provides a mock function with given fields: _a0, _a1
+func (_m *IPaymentClient) ExpirePayment(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPaymentStatus provides a mock function with given fields: _a0, _a1
+func (_m *IPaymentClient) GetPaymentStatus(_a0 context.Context, _a1 uuid.UUID) (*clients.PaymentData, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *clients.PaymentData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*clients.PaymentData, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *clients.PaymentData); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clients.PaymentData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refund provides a mock function with given fields: _a0, _a1
+func (_m *IPaymentClient) Refund(_a0 context.Context, _a1 *clients.RefundRequest) (*clients.RefundData, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *clients.RefundData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.RefundRequest) (*clients.RefundData, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clients.RefundRequest) *clients.RefundData); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clients.RefundData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clients.RefundRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIPaymentClient creates a new instance of IPaymentClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIPaymentClient(t interface {
@@ -14,13 +14,13 @@ type ICircuitBreaker struct {
 	mock.Mock
 }
 
-// Execute provides a mock function with given fields: _a0, _a1
-func (_m *ICircuitBreaker) Execute(_a0 context.Context, _a1 circuitbreaker.BreakerFunc) error {
-	ret := _m.Called(_a0, _a1)
+// Execute provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ICircuitBreaker) Execute(_a0 context.Context, _a1 string, _a2 circuitbreaker.BreakerFunc) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, circuitbreaker.BreakerFunc) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, circuitbreaker.BreakerFunc) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,6 +28,22 @@ func (_m *ICircuitBreaker) Execute(_a0 context.Context, _a1 circuitbreaker.Break
 	return r0
 }
 
+// States provides a mock function with given fields:
+func (_m *ICircuitBreaker) States() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // NewICircuitBreaker creates a new instance of ICircuitBreaker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewICircuitBreaker(t interface {
@@ -0,0 +1,43 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	health "order-service/common/health"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Check is an autogenerated mock type for the Check type
+type Check struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *Check) Execute(_a0 context.Context) health.Component {
+	ret := _m.Called(_a0)
+
+	var r0 health.Component
+	if rf, ok := ret.Get(0).(func(context.Context) health.Component); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(health.Component)
+	}
+
+	return r0
+}
+
+// NewCheck creates a new instance of Check. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCheck(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Check {
+	mock := &Check{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
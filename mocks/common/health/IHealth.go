@@ -0,0 +1,57 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	health "order-service/common/health"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IHealth is an autogenerated mock type for the IHealth type
+type IHealth struct {
+	mock.Mock
+}
+
+// Live provides a mock function with given fields: _a0
+func (_m *IHealth) Live(_a0 context.Context) health.Report {
+	ret := _m.Called(_a0)
+
+	var r0 health.Report
+	if rf, ok := ret.Get(0).(func(context.Context) health.Report); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(health.Report)
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields: _a0
+func (_m *IHealth) Ready(_a0 context.Context) health.Report {
+	ret := _m.Called(_a0)
+
+	var r0 health.Report
+	if rf, ok := ret.Get(0).(func(context.Context) health.Report); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(health.Report)
+	}
+
+	return r0
+}
+
+// NewIHealth creates a new instance of IHealth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIHealth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IHealth {
+	mock := &IHealth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	health "order-service/common/health"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Option is an autogenerated mock type for the Option type
+type Option struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *Option) Execute(_a0 *health.Health) {
+	_m.Called(_a0)
+}
+
+// NewOption creates a new instance of Option. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Option {
+	mock := &Option{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	kafka "order-service/common/kafka"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IProducer is an autogenerated mock type for the IProducer type
+type IProducer struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields:
+func (_m *IProducer) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Produce provides a mock function with given fields: _a0, _a1
+func (_m *IProducer) Produce(_a0 context.Context, _a1 *kafka.Message) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.Message) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIProducer creates a new instance of IProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIProducer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IProducer {
+	mock := &IProducer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,9 +4,11 @@ package mocks
 
 import (
 	context "context"
+	commonsentry "order-service/common/sentry"
 
-	sentry "github.com/getsentry/sentry-go"
 	mock "github.com/stretchr/testify/mock"
+
+	sentry "github.com/getsentry/sentry-go"
 )
 
 // ISentry is an autogenerated mock type for the ISentry type
@@ -30,17 +32,63 @@ func (_m *ISentry) CaptureException(exception error) *sentry.EventID {
 	return r0
 }
 
+// Extract provides a mock function with given fields: ctx, headers
+func (_m *ISentry) Extract(ctx context.Context, headers map[string]string) context.Context {
+	ret := _m.Called(ctx, headers)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) context.Context); ok {
+		r0 = rf(ctx, headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
 // Finish provides a mock function with given fields: span
-func (_m *ISentry) Finish(span *sentry.Span) {
+func (_m *ISentry) Finish(span commonsentry.Span) {
 	_m.Called(span)
 }
 
+// Flush provides a mock function with given fields: ctx
+func (_m *ISentry) Flush(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Inject provides a mock function with given fields: ctx
+func (_m *ISentry) Inject(ctx context.Context) map[string]string {
+	ret := _m.Called(ctx)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // SpanContext provides a mock function with given fields: span
-func (_m *ISentry) SpanContext(span *sentry.Span) context.Context {
+func (_m *ISentry) SpanContext(span commonsentry.Span) context.Context {
 	ret := _m.Called(span)
 
 	var r0 context.Context
-	if rf, ok := ret.Get(0).(func(*sentry.Span) context.Context); ok {
+	if rf, ok := ret.Get(0).(func(commonsentry.Span) context.Context); ok {
 		r0 = rf(span)
 	} else {
 		if ret.Get(0) != nil {
@@ -52,15 +100,15 @@ func (_m *ISentry) SpanContext(span *sentry.Span) context.Context {
 }
 
 // StartSpan provides a mock function with given fields: ctx, spanName
-func (_m *ISentry) StartSpan(ctx context.Context, spanName string) *sentry.Span {
+func (_m *ISentry) StartSpan(ctx context.Context, spanName string) commonsentry.Span {
 	ret := _m.Called(ctx, spanName)
 
-	var r0 *sentry.Span
-	if rf, ok := ret.Get(0).(func(context.Context, string) *sentry.Span); ok {
+	var r0 commonsentry.Span
+	if rf, ok := ret.Get(0).(func(context.Context, string) commonsentry.Span); ok {
 		r0 = rf(ctx, spanName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sentry.Span)
+			r0 = ret.Get(0).(commonsentry.Span)
 		}
 	}
 
@@ -0,0 +1,49 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Span is an autogenerated mock type for the Span type
+type Span struct {
+	mock.Mock
+}
+
+// Context provides a mock function with given fields:
+func (_m *Span) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// Finish provides a mock function with given fields:
+func (_m *Span) Finish() {
+	_m.Called()
+}
+
+// NewSpan creates a new instance of Span. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSpan(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Span {
+	mock := &Span{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	sentry "order-service/common/sentry"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TelemetryOption is an autogenerated mock type for the TelemetryOption type
+type TelemetryOption struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: _a0
+func (_m *TelemetryOption) Execute(_a0 *sentry.OpenTelemetry) {
+	_m.Called(_a0)
+}
+
+// NewTelemetryOption creates a new instance of TelemetryOption. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTelemetryOption(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TelemetryOption {
+	mock := &TelemetryOption{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,9 +3,17 @@
 package mocks
 
 import (
-	controllers "order-service/controllers/http/suborder"
+	controllers "order-service/controllers/http/installmentplan"
 
 	mock "github.com/stretchr/testify/mock"
+
+	order "order-service/controllers/http/order"
+
+	parkedmessage "order-service/controllers/http/parkedmessage"
+
+	suborder "order-service/controllers/http/suborder"
+
+	voucher "order-service/controllers/http/voucher"
 )
 
 // IControllerRegistry is an autogenerated mock type for the IControllerRegistry type
@@ -13,16 +21,80 @@ type IControllerRegistry struct {
 	mock.Mock
 }
 
+// GetInstallmentPlan provides a mock function with given fields:
+func (_m *IControllerRegistry) GetInstallmentPlan() controllers.IInstallmentPlanController {
+	ret := _m.Called()
+
+	var r0 controllers.IInstallmentPlanController
+	if rf, ok := ret.Get(0).(func() controllers.IInstallmentPlanController); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(controllers.IInstallmentPlanController)
+		}
+	}
+
+	return r0
+}
+
+// GetOrder provides a mock function with given fields:
+func (_m *IControllerRegistry) GetOrder() order.IOrderController {
+	ret := _m.Called()
+
+	var r0 order.IOrderController
+	if rf, ok := ret.Get(0).(func() order.IOrderController); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(order.IOrderController)
+		}
+	}
+
+	return r0
+}
+
+// GetParkedMessage provides a mock function with given fields:
+func (_m *IControllerRegistry) GetParkedMessage() parkedmessage.IParkedMessageController {
+	ret := _m.Called()
+
+	var r0 parkedmessage.IParkedMessageController
+	if rf, ok := ret.Get(0).(func() parkedmessage.IParkedMessageController); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(parkedmessage.IParkedMessageController)
+		}
+	}
+
+	return r0
+}
+
 // GetSubOrder provides a mock function with given fields:
-func (_m *IControllerRegistry) GetSubOrder() controllers.ISubOrderController {
+func (_m *IControllerRegistry) GetSubOrder() suborder.ISubOrderController {
+	ret := _m.Called()
+
+	var r0 suborder.ISubOrderController
+	if rf, ok := ret.Get(0).(func() suborder.ISubOrderController); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(suborder.ISubOrderController)
+		}
+	}
+
+	return r0
+}
+
+// GetVoucher provides a mock function with given fields:
+func (_m *IControllerRegistry) GetVoucher() voucher.IVoucherController {
 	ret := _m.Called()
 
-	var r0 controllers.ISubOrderController
-	if rf, ok := ret.Get(0).(func() controllers.ISubOrderController); ok {
+	var r0 voucher.IVoucherController
+	if rf, ok := ret.Get(0).(func() voucher.IVoucherController); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(controllers.ISubOrderController)
+			r0 = ret.Get(0).(voucher.IVoucherController)
 		}
 	}
 
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IInstallmentPlanController is an autogenerated mock type for the IInstallmentPlanController type
+type IInstallmentPlanController struct {
+	mock.Mock
+}
+
+// AssignPackage provides a mock function with given fields: c
+func (_m *IInstallmentPlanController) AssignPackage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CreateInstallmentPlan provides a mock function with given fields: c
+func (_m *IInstallmentPlanController) CreateInstallmentPlan(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetInstallmentPlanList provides a mock function with given fields: c
+func (_m *IInstallmentPlanController) GetInstallmentPlanList(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewIInstallmentPlanController creates a new instance of IInstallmentPlanController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIInstallmentPlanController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IInstallmentPlanController {
+	mock := &IInstallmentPlanController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
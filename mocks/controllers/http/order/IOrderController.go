@@ -0,0 +1,32 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IOrderController is an autogenerated mock type for the IOrderController type
+type IOrderController struct {
+	mock.Mock
+}
+
+// GetOrderDetail provides a mock function with given fields: c
+func (_m *IOrderController) GetOrderDetail(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewIOrderController creates a new instance of IOrderController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderController {
+	mock := &IOrderController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,37 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IParkedMessageController is an autogenerated mock type for the IParkedMessageController type
+type IParkedMessageController struct {
+	mock.Mock
+}
+
+// GetParkedMessageList provides a mock function with given fields: c
+func (_m *IParkedMessageController) GetParkedMessageList(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReplayParkedMessage provides a mock function with given fields: c
+func (_m *IParkedMessageController) ReplayParkedMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewIParkedMessageController creates a new instance of IParkedMessageController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIParkedMessageController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IParkedMessageController {
+	mock := &IParkedMessageController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
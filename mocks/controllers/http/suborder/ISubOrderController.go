@@ -12,6 +12,11 @@ type ISubOrderController struct {
 	mock.Mock
 }
 
+// CancelMyOrder provides a mock function with given fields: c
+func (_m *ISubOrderController) CancelMyOrder(c *gin.Context) {
+	_m.Called(c)
+}
+
 // CancelOrder provides a mock function with given fields: c
 func (_m *ISubOrderController) CancelOrder(c *gin.Context) {
 	_m.Called(c)
@@ -22,6 +27,16 @@ func (_m *ISubOrderController) CreateOrder(c *gin.Context) {
 	_m.Called(c)
 }
 
+// GetMyOrderDetail provides a mock function with given fields: c
+func (_m *ISubOrderController) GetMyOrderDetail(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetMyOrderList provides a mock function with given fields: c
+func (_m *ISubOrderController) GetMyOrderList(c *gin.Context) {
+	_m.Called(c)
+}
+
 // GetSubOrderDetail provides a mock function with given fields: c
 func (_m *ISubOrderController) GetSubOrderDetail(c *gin.Context) {
 	_m.Called(c)
@@ -32,6 +47,16 @@ func (_m *ISubOrderController) GetSubOrderList(c *gin.Context) {
 	_m.Called(c)
 }
 
+// RefundOrder provides a mock function with given fields: c
+func (_m *ISubOrderController) RefundOrder(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RegeneratePaymentLink provides a mock function with given fields: c
+func (_m *ISubOrderController) RegeneratePaymentLink(c *gin.Context) {
+	_m.Called(c)
+}
+
 // NewISubOrderController creates a new instance of ISubOrderController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewISubOrderController(t interface {
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IVoucherController is an autogenerated mock type for the IVoucherController type
+type IVoucherController struct {
+	mock.Mock
+}
+
+// CreateVoucher provides a mock function with given fields: c
+func (_m *IVoucherController) CreateVoucher(c *gin.Context) {
+	_m.Called(c)
+}
+
+// DeleteVoucher provides a mock function with given fields: c
+func (_m *IVoucherController) DeleteVoucher(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetVoucherDetail provides a mock function with given fields: c
+func (_m *IVoucherController) GetVoucherDetail(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetVoucherList provides a mock function with given fields: c
+func (_m *IVoucherController) GetVoucherList(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UpdateVoucher provides a mock function with given fields: c
+func (_m *IVoucherController) UpdateVoucher(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewIVoucherController creates a new instance of IVoucherController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIVoucherController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IVoucherController {
+	mock := &IVoucherController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
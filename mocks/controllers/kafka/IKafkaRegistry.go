@@ -3,9 +3,14 @@
 package mocks
 
 import (
-	controllers "order-service/controllers/kafka/payment"
+	context "context"
+	controllers "order-service/controllers/kafka/deadletter"
 
 	mock "github.com/stretchr/testify/mock"
+
+	payment "order-service/controllers/kafka/payment"
+
+	sarama "github.com/IBM/sarama"
 )
 
 // IKafkaRegistry is an autogenerated mock type for the IKafkaRegistry type
@@ -13,16 +18,48 @@ type IKafkaRegistry struct {
 	mock.Mock
 }
 
+// GetDeadLetter provides a mock function with given fields:
+func (_m *IKafkaRegistry) GetDeadLetter() controllers.IDeadLetterKafka {
+	ret := _m.Called()
+
+	var r0 controllers.IDeadLetterKafka
+	if rf, ok := ret.Get(0).(func() controllers.IDeadLetterKafka); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(controllers.IDeadLetterKafka)
+		}
+	}
+
+	return r0
+}
+
 // GetPayment provides a mock function with given fields:
-func (_m *IKafkaRegistry) GetPayment() controllers.IPaymentKafka {
+func (_m *IKafkaRegistry) GetPayment() payment.IPaymentKafka {
 	ret := _m.Called()
 
-	var r0 controllers.IPaymentKafka
-	if rf, ok := ret.Get(0).(func() controllers.IPaymentKafka); ok {
+	var r0 payment.IPaymentKafka
+	if rf, ok := ret.Get(0).(func() payment.IPaymentKafka); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(controllers.IPaymentKafka)
+			r0 = ret.Get(0).(payment.IPaymentKafka)
+		}
+	}
+
+	return r0
+}
+
+// GetTopicHandler provides a mock function with given fields: topic
+func (_m *IKafkaRegistry) GetTopicHandler(topic string) func(context.Context, *sarama.ConsumerMessage) error {
+	ret := _m.Called(topic)
+
+	var r0 func(context.Context, *sarama.ConsumerMessage) error
+	if rf, ok := ret.Get(0).(func(string) func(context.Context, *sarama.ConsumerMessage) error); ok {
+		r0 = rf(topic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(context.Context, *sarama.ConsumerMessage) error)
 		}
 	}
 
@@ -0,0 +1,44 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sarama "github.com/IBM/sarama"
+)
+
+// IDeadLetterKafka is an autogenerated mock type for the IDeadLetterKafka type
+type IDeadLetterKafka struct {
+	mock.Mock
+}
+
+// HandleDeadLetter provides a mock function with given fields: ctx, message, err, retries
+func (_m *IDeadLetterKafka) HandleDeadLetter(ctx context.Context, message *sarama.ConsumerMessage, err error, retries int) error {
+	ret := _m.Called(ctx, message, err, retries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sarama.ConsumerMessage, error, int) error); ok {
+		r0 = rf(ctx, message, err, retries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIDeadLetterKafka creates a new instance of IDeadLetterKafka. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDeadLetterKafka(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDeadLetterKafka {
+	mock := &IDeadLetterKafka{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
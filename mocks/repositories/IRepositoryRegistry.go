@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	order "order-service/repositories/order"
+
 	mock "github.com/stretchr/testify/mock"
 	gorm "gorm.io/gorm"
 
@@ -10,11 +12,23 @@ import (
 
 	orderinvoice "order-service/repositories/orderinvoice"
 
+	orderoutbox "order-service/repositories/orderoutbox"
+
 	orderpayment "order-service/repositories/orderpayment"
 
-	repositories "order-service/repositories/order"
+	orderrefund "order-service/repositories/orderrefund"
+
+	parkedmessage "order-service/repositories/parkedmessage"
+
+	paymentreminder "order-service/repositories/paymentreminder"
+
+	processedevent "order-service/repositories/processedevent"
+
+	repositories "order-service/repositories/installmentplan"
 
 	suborder "order-service/repositories/suborder"
+
+	voucher "order-service/repositories/voucher"
 )
 
 // IRepositoryRegistry is an autogenerated mock type for the IRepositoryRegistry type
@@ -22,16 +36,32 @@ type IRepositoryRegistry struct {
 	mock.Mock
 }
 
+// GetInstallmentPlan provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetInstallmentPlan() repositories.IInstallmentPlanRepository {
+	ret := _m.Called()
+
+	var r0 repositories.IInstallmentPlanRepository
+	if rf, ok := ret.Get(0).(func() repositories.IInstallmentPlanRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repositories.IInstallmentPlanRepository)
+		}
+	}
+
+	return r0
+}
+
 // GetOrder provides a mock function with given fields:
-func (_m *IRepositoryRegistry) GetOrder() repositories.IOrderRepository {
+func (_m *IRepositoryRegistry) GetOrder() order.IOrderRepository {
 	ret := _m.Called()
 
-	var r0 repositories.IOrderRepository
-	if rf, ok := ret.Get(0).(func() repositories.IOrderRepository); ok {
+	var r0 order.IOrderRepository
+	if rf, ok := ret.Get(0).(func() order.IOrderRepository); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(repositories.IOrderRepository)
+			r0 = ret.Get(0).(order.IOrderRepository)
 		}
 	}
 
@@ -70,6 +100,22 @@ func (_m *IRepositoryRegistry) GetOrderInvoice() orderinvoice.IOrderInvoiceRepos
 	return r0
 }
 
+// GetOrderOutbox provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetOrderOutbox() orderoutbox.IOrderOutboxRepository {
+	ret := _m.Called()
+
+	var r0 orderoutbox.IOrderOutboxRepository
+	if rf, ok := ret.Get(0).(func() orderoutbox.IOrderOutboxRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orderoutbox.IOrderOutboxRepository)
+		}
+	}
+
+	return r0
+}
+
 // GetOrderPayment provides a mock function with given fields:
 func (_m *IRepositoryRegistry) GetOrderPayment() orderpayment.IOrderPaymentRepository {
 	ret := _m.Called()
@@ -86,6 +132,70 @@ func (_m *IRepositoryRegistry) GetOrderPayment() orderpayment.IOrderPaymentRepos
 	return r0
 }
 
+// GetOrderRefund provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetOrderRefund() orderrefund.IOrderRefundRepository {
+	ret := _m.Called()
+
+	var r0 orderrefund.IOrderRefundRepository
+	if rf, ok := ret.Get(0).(func() orderrefund.IOrderRefundRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orderrefund.IOrderRefundRepository)
+		}
+	}
+
+	return r0
+}
+
+// GetParkedMessage provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetParkedMessage() parkedmessage.IParkedMessageRepository {
+	ret := _m.Called()
+
+	var r0 parkedmessage.IParkedMessageRepository
+	if rf, ok := ret.Get(0).(func() parkedmessage.IParkedMessageRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(parkedmessage.IParkedMessageRepository)
+		}
+	}
+
+	return r0
+}
+
+// GetPaymentReminder provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetPaymentReminder() paymentreminder.IPaymentReminderRepository {
+	ret := _m.Called()
+
+	var r0 paymentreminder.IPaymentReminderRepository
+	if rf, ok := ret.Get(0).(func() paymentreminder.IPaymentReminderRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(paymentreminder.IPaymentReminderRepository)
+		}
+	}
+
+	return r0
+}
+
+// GetProcessedEvent provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetProcessedEvent() processedevent.IProcessedEventRepository {
+	ret := _m.Called()
+
+	var r0 processedevent.IProcessedEventRepository
+	if rf, ok := ret.Get(0).(func() processedevent.IProcessedEventRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(processedevent.IProcessedEventRepository)
+		}
+	}
+
+	return r0
+}
+
 // GetSubOrder provides a mock function with given fields:
 func (_m *IRepositoryRegistry) GetSubOrder() suborder.ISubOrderRepository {
 	ret := _m.Called()
@@ -118,6 +228,22 @@ func (_m *IRepositoryRegistry) GetTx() *gorm.DB {
 	return r0
 }
 
+// GetVoucher provides a mock function with given fields:
+func (_m *IRepositoryRegistry) GetVoucher() voucher.IVoucherRepository {
+	ret := _m.Called()
+
+	var r0 voucher.IVoucherRepository
+	if rf, ok := ret.Get(0).(func() voucher.IVoucherRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(voucher.IVoucherRepository)
+		}
+	}
+
+	return r0
+}
+
 // NewIRepositoryRegistry creates a new instance of IRepositoryRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIRepositoryRegistry(t interface {
@@ -0,0 +1,224 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/installmentplan"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+)
+
+// IInstallmentPlanRepository is an autogenerated mock type for the IInstallmentPlanRepository type
+type IInstallmentPlanRepository struct {
+	mock.Mock
+}
+
+// AssignPackage provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IInstallmentPlanRepository) AssignPackage(_a0 context.Context, _a1 *gorm.DB, _a2 string, _a3 uint) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearDefault provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanRepository) ClearDefault(_a0 context.Context, _a1 *gorm.DB) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IInstallmentPlanRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.InstallmentPlanRequest) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.InstallmentPlanRequest) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.InstallmentPlanRequest) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.InstallmentPlanRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllWithPagination provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanRepository) FindAllWithPagination(_a0 context.Context, _a1 *dto.InstallmentPlanRequestParam) ([]models.InstallmentPlan, int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.InstallmentPlan
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequestParam) ([]models.InstallmentPlan, int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequestParam) []models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.InstallmentPlanRequestParam) int64); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *dto.InstallmentPlanRequestParam) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindDefault provides a mock function with given fields: _a0
+func (_m *IInstallmentPlanRepository) FindDefault(_a0 context.Context) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.InstallmentPlan); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByID provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanRepository) FindOneByID(_a0 context.Context, _a1 uint) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByPackageID provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanRepository) FindOneByPackageID(_a0 context.Context, _a1 string) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByUUID provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanRepository) FindOneByUUID(_a0 context.Context, _a1 string) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIInstallmentPlanRepository creates a new instance of IInstallmentPlanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIInstallmentPlanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IInstallmentPlanRepository {
+	mock := &IInstallmentPlanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
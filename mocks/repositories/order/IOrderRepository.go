@@ -12,6 +12,8 @@ import (
 
 	models "order-service/domain/models"
 
+	money "order-service/common/money"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -20,6 +22,20 @@ type IOrderRepository struct {
 	mock.Mock
 }
 
+// AddRemainingOutstandingAmount provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IOrderRepository) AddRemainingOutstandingAmount(_a0 context.Context, _a1 *gorm.DB, _a2 uint, _a3 money.Money) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint, money.Money) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IOrderRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.OrderRequest) (*models.Order, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -60,6 +76,32 @@ func (_m *IOrderRepository) DeleteByOrderID(_a0 context.Context, _a1 *gorm.DB, _
 	return r0
 }
 
+// FindOneAggregateByUUID provides a mock function with given fields: _a0, _a1
+func (_m *IOrderRepository) FindOneAggregateByUUID(_a0 context.Context, _a1 string) (*models.Order, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Order, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Order); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOneOrderByCustomerIDWithLocking provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IOrderRepository) FindOneOrderByCustomerIDWithLocking(_a0 context.Context, _a1 *gorm.DB, _a2 uuid.UUID) (*models.Order, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -112,6 +154,32 @@ func (_m *IOrderRepository) FindOneOrderByID(_a0 context.Context, _a1 uint) (*mo
 	return r0, r1
 }
 
+// FindOneOrderByIDWithLocking provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderRepository) FindOneOrderByIDWithLocking(_a0 context.Context, _a1 *gorm.DB, _a2 uint) (*models.Order, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) (*models.Order, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) *models.Order); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOneOrderByUUID provides a mock function with given fields: _a0, _a1
 func (_m *IOrderRepository) FindOneOrderByUUID(_a0 context.Context, _a1 uuid.UUID) (*models.Order, error) {
 	ret := _m.Called(_a0, _a1)
@@ -138,6 +206,20 @@ func (_m *IOrderRepository) FindOneOrderByUUID(_a0 context.Context, _a1 uuid.UUI
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderRepository) Restore(_a0 context.Context, _a1 *gorm.DB, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, db, request
 func (_m *IOrderRepository) Update(ctx context.Context, db *gorm.DB, request *dto.OrderRequest) error {
 	ret := _m.Called(ctx, db, request)
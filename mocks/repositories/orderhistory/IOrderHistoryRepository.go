@@ -9,6 +9,8 @@ import (
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
 )
 
 // IOrderHistoryRepository is an autogenerated mock type for the IOrderHistoryRepository type
@@ -44,6 +46,32 @@ func (_m *IOrderHistoryRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2
 	return r0
 }
 
+// FindLatestBySubOrderID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderHistoryRepository) FindLatestBySubOrderID(_a0 context.Context, _a1 *gorm.DB, _a2 uint) (*models.OrderHistory, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.OrderHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) (*models.OrderHistory, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) *models.OrderHistory); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIOrderHistoryRepository creates a new instance of IOrderHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIOrderHistoryRepository(t interface {
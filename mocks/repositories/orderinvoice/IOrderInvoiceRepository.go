@@ -31,6 +31,58 @@ func (_m *IOrderInvoiceRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2
 	return r0
 }
 
+// FindAllBySubOrderIDs provides a mock function with given fields: _a0, _a1
+func (_m *IOrderInvoiceRepository) FindAllBySubOrderIDs(_a0 context.Context, _a1 []uint) ([]models.OrderInvoice, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.OrderInvoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) ([]models.OrderInvoice, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) []models.OrderInvoice); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderInvoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindBySubOrderID provides a mock function with given fields: _a0, _a1
+func (_m *IOrderInvoiceRepository) FindBySubOrderID(_a0 context.Context, _a1 uint) (*models.OrderInvoice, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.OrderInvoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.OrderInvoice, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.OrderInvoice); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderInvoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIOrderInvoiceRepository creates a new instance of IOrderInvoiceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIOrderInvoiceRepository(t interface {
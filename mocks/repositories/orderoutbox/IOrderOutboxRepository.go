@@ -0,0 +1,125 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/outbox"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+
+	time "time"
+)
+
+// IOrderOutboxRepository is an autogenerated mock type for the IOrderOutboxRepository type
+type IOrderOutboxRepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderOutboxRepository) Claim(_a0 context.Context, _a1 uint, _a2 time.Time) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) (bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderOutboxRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.OutboxRequest) (*models.OrderOutbox, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.OrderOutbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.OutboxRequest) (*models.OrderOutbox, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.OutboxRequest) *models.OrderOutbox); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderOutbox)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.OutboxRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllDue provides a mock function with given fields: _a0, _a1
+func (_m *IOrderOutboxRepository) FindAllDue(_a0 context.Context, _a1 int) ([]models.OrderOutbox, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.OrderOutbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.OrderOutbox, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.OrderOutbox); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderOutbox)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderOutboxRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.UpdateOutboxRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.UpdateOutboxRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIOrderOutboxRepository creates a new instance of IOrderOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderOutboxRepository {
+	mock := &IOrderOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -32,6 +32,32 @@ func (_m *IOrderPaymentRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2
 	return r0
 }
 
+// FindAllUnsettled provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderPaymentRepository) FindAllUnsettled(_a0 context.Context, _a1 uint, _a2 int) ([]dto.UnsettledPayment, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []dto.UnsettledPayment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) ([]dto.UnsettledPayment, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) []dto.UnsettledPayment); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UnsettledPayment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByPaymentID provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IOrderPaymentRepository) FindByPaymentID(_a0 context.Context, _a1 *gorm.DB, _a2 string) (*models.OrderPayment, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -58,6 +84,46 @@ func (_m *IOrderPaymentRepository) FindByPaymentID(_a0 context.Context, _a1 *gor
 	return r0, r1
 }
 
+// FindBySubOrderID provides a mock function with given fields: _a0, _a1
+func (_m *IOrderPaymentRepository) FindBySubOrderID(_a0 context.Context, _a1 uint) (*models.OrderPayment, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.OrderPayment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.OrderPayment, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.OrderPayment); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderPayment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SupersedeBySubOrderID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderPaymentRepository) SupersedeBySubOrderID(_a0 context.Context, _a1 *gorm.DB, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IOrderPaymentRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.OrderPaymentRequest) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -72,6 +138,20 @@ func (_m *IOrderPaymentRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2
 	return r0
 }
 
+// UpdateStatusBySubOrderID provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IOrderPaymentRepository) UpdateStatusBySubOrderID(_a0 context.Context, _a1 *gorm.DB, _a2 uint, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIOrderPaymentRepository creates a new instance of IOrderPaymentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIOrderPaymentRepository(t interface {
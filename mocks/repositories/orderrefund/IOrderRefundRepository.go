@@ -0,0 +1,151 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/orderrefund"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+)
+
+// IOrderRefundRepository is an autogenerated mock type for the IOrderRefundRepository type
+type IOrderRefundRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderRefundRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.OrderRefundRequest) (*models.OrderRefund, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.OrderRefund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.OrderRefundRequest) (*models.OrderRefund, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.OrderRefundRequest) *models.OrderRefund); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderRefund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.OrderRefundRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllBySubOrderID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderRefundRepository) FindAllBySubOrderID(_a0 context.Context, _a1 *gorm.DB, _a2 uint) ([]models.OrderRefund, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.OrderRefund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) ([]models.OrderRefund, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) []models.OrderRefund); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderRefund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllSucceededBySubOrderIDs provides a mock function with given fields: _a0, _a1
+func (_m *IOrderRefundRepository) FindAllSucceededBySubOrderIDs(_a0 context.Context, _a1 []uint) ([]models.OrderRefund, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.OrderRefund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) ([]models.OrderRefund, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) []models.OrderRefund); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrderRefund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByUUID provides a mock function with given fields: _a0, _a1
+func (_m *IOrderRefundRepository) FindOneByUUID(_a0 context.Context, _a1 string) (*models.OrderRefund, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.OrderRefund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrderRefund, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrderRefund); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrderRefund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IOrderRefundRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.UpdateOrderRefundRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.UpdateOrderRefundRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIOrderRefundRepository creates a new instance of IOrderRefundRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderRefundRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderRefundRepository {
+	mock := &IOrderRefundRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
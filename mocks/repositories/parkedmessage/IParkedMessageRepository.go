@@ -0,0 +1,132 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/parkedmessage"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+)
+
+// IParkedMessageRepository is an autogenerated mock type for the IParkedMessageRepository type
+type IParkedMessageRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IParkedMessageRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.ParkedMessageRequest) (*models.ParkedMessage, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.ParkedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.ParkedMessageRequest) (*models.ParkedMessage, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.ParkedMessageRequest) *models.ParkedMessage); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ParkedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.ParkedMessageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllWithPagination provides a mock function with given fields: _a0, _a1
+func (_m *IParkedMessageRepository) FindAllWithPagination(_a0 context.Context, _a1 *dto.ParkedMessageRequestParam) ([]models.ParkedMessage, int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.ParkedMessage
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ParkedMessageRequestParam) ([]models.ParkedMessage, int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ParkedMessageRequestParam) []models.ParkedMessage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ParkedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ParkedMessageRequestParam) int64); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *dto.ParkedMessageRequestParam) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindOneByUUID provides a mock function with given fields: _a0, _a1
+func (_m *IParkedMessageRepository) FindOneByUUID(_a0 context.Context, _a1 string) (*models.ParkedMessage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.ParkedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.ParkedMessage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.ParkedMessage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ParkedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IParkedMessageRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.UpdateParkedMessageRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.UpdateParkedMessageRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIParkedMessageRepository creates a new instance of IParkedMessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIParkedMessageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IParkedMessageRepository {
+	mock := &IParkedMessageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/paymentreminder"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IPaymentReminderRepository is an autogenerated mock type for the IPaymentReminderRepository type
+type IPaymentReminderRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IPaymentReminderRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.PaymentReminderRequest) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.PaymentReminderRequest) (bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.PaymentReminderRequest) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.PaymentReminderRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllDue provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IPaymentReminderRepository) FindAllDue(_a0 context.Context, _a1 time.Time, _a2 int, _a3 int) ([]dto.DuePaymentReminder, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []dto.DuePaymentReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) ([]dto.DuePaymentReminder, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) []dto.DuePaymentReminder); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DuePaymentReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIPaymentReminderRepository creates a new instance of IPaymentReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentReminderRepository {
+	mock := &IPaymentReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,10 +8,14 @@ import (
 
 	gorm "gorm.io/gorm"
 
+	helper "order-service/utils/helper"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "order-service/domain/models"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -86,6 +90,39 @@ func (_m *ISubOrderRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *mo
 	return r0, r1
 }
 
+// FindAllByCustomerIDWithPagination provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) FindAllByCustomerIDWithPagination(_a0 context.Context, _a1 string, _a2 *dto.SubOrderRequestParam) ([]models.SubOrder, int64, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.SubOrder
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.SubOrderRequestParam) ([]models.SubOrder, int64, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.SubOrderRequestParam) []models.SubOrder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SubOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dto.SubOrderRequestParam) int64); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *dto.SubOrderRequestParam) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindAllByOrderID provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderRepository) FindAllByOrderID(_a0 context.Context, _a1 uint) ([]models.SubOrder, error) {
 	ret := _m.Called(_a0, _a1)
@@ -112,6 +149,58 @@ func (_m *ISubOrderRepository) FindAllByOrderID(_a0 context.Context, _a1 uint) (
 	return r0, r1
 }
 
+// FindAllExpired provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) FindAllExpired(_a0 context.Context, _a1 time.Time, _a2 int) ([]models.SubOrder, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.SubOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]models.SubOrder, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []models.SubOrder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SubOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllWithCursor provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) FindAllWithCursor(_a0 context.Context, _a1 *dto.SubOrderRequestParam, _a2 *helper.Cursor) ([]models.SubOrder, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.SubOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam, *helper.Cursor) ([]models.SubOrder, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam, *helper.Cursor) []models.SubOrder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SubOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SubOrderRequestParam, *helper.Cursor) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindAllWithPagination provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderRepository) FindAllWithPagination(_a0 context.Context, _a1 *dto.SubOrderRequestParam) ([]models.SubOrder, int64, error) {
 	ret := _m.Called(_a0, _a1)
@@ -197,6 +286,58 @@ func (_m *ISubOrderRepository) FindOneByUUID(_a0 context.Context, _a1 string) (*
 	return r0, r1
 }
 
+// FindOneByUUIDAndCustomerID provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) FindOneByUUIDAndCustomerID(_a0 context.Context, _a1 string, _a2 string) (*models.SubOrder, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.SubOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.SubOrder, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.SubOrder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByUUIDWithLocking provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) FindOneByUUIDWithLocking(_a0 context.Context, _a1 *gorm.DB, _a2 string) (*models.SubOrder, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.SubOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string) (*models.SubOrder, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string) *models.SubOrder); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOneSubOrderByCustomerIDWithLocking provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderRepository) FindOneSubOrderByCustomerIDWithLocking(_a0 context.Context, _a1 uuid.UUID) (*models.SubOrder, error) {
 	ret := _m.Called(_a0, _a1)
@@ -223,6 +364,20 @@ func (_m *ISubOrderRepository) FindOneSubOrderByCustomerIDWithLocking(_a0 contex
 	return r0, r1
 }
 
+// Reopen provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderRepository) Reopen(_a0 context.Context, _a1 *gorm.DB, _a2 *models.SubOrder) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *models.SubOrder) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *ISubOrderRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.UpdateSubOrderRequest, _a3 *models.SubOrder) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
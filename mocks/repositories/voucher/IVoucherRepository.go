@@ -0,0 +1,262 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/voucher"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+)
+
+// IVoucherRepository is an autogenerated mock type for the IVoucherRepository type
+type IVoucherRepository struct {
+	mock.Mock
+}
+
+// CountRedemptionByCustomerID provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IVoucherRepository) CountRedemptionByCustomerID(_a0 context.Context, _a1 *gorm.DB, _a2 uint, _a3 string) (int64, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint, string) (int64, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint, string) int64); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, uint, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherRepository) Create(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.VoucherRequest) (*models.Voucher, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.VoucherRequest) (*models.Voucher, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.VoucherRequest) *models.Voucher); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.VoucherRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherRepository) Delete(_a0 context.Context, _a1 *gorm.DB, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAllWithPagination provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherRepository) FindAllWithPagination(_a0 context.Context, _a1 *dto.VoucherRequestParam) ([]models.Voucher, int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.Voucher
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequestParam) ([]models.Voucher, int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequestParam) []models.Voucher); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.VoucherRequestParam) int64); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *dto.VoucherRequestParam) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindOneByCode provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherRepository) FindOneByCode(_a0 context.Context, _a1 string) (*models.Voucher, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Voucher, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Voucher); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByCodeWithLocking provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherRepository) FindOneByCodeWithLocking(_a0 context.Context, _a1 *gorm.DB, _a2 string) (*models.Voucher, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string) (*models.Voucher, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string) *models.Voucher); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneByUUID provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherRepository) FindOneByUUID(_a0 context.Context, _a1 string) (*models.Voucher, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Voucher, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Voucher); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeem provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherRepository) Redeem(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.RedeemVoucherRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.RedeemVoucherRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Release provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherRepository) Release(_a0 context.Context, _a1 *gorm.DB, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IVoucherRepository) Update(_a0 context.Context, _a1 *gorm.DB, _a2 *models.Voucher, _a3 *dto.VoucherRequest) (*models.Voucher, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *models.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *models.Voucher, *dto.VoucherRequest) (*models.Voucher, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *models.Voucher, *dto.VoucherRequest) *models.Voucher); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *models.Voucher, *dto.VoucherRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIVoucherRepository creates a new instance of IVoucherRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIVoucherRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IVoucherRepository {
+	mock := &IVoucherRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
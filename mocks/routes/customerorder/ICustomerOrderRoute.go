@@ -0,0 +1,29 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ICustomerOrderRoute is an autogenerated mock type for the ICustomerOrderRoute type
+type ICustomerOrderRoute struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields:
+func (_m *ICustomerOrderRoute) Run() {
+	_m.Called()
+}
+
+// NewICustomerOrderRoute creates a new instance of ICustomerOrderRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICustomerOrderRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICustomerOrderRoute {
+	mock := &ICustomerOrderRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// IInstallmentPlanRoute is an autogenerated mock type for the IInstallmentPlanRoute type
+type IInstallmentPlanRoute struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields:
+func (_m *IInstallmentPlanRoute) Run() {
+	_m.Called()
+}
+
+// NewIInstallmentPlanRoute creates a new instance of IInstallmentPlanRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIInstallmentPlanRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IInstallmentPlanRoute {
+	mock := &IInstallmentPlanRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// IOrderRoute is an autogenerated mock type for the IOrderRoute type
+type IOrderRoute struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields:
+func (_m *IOrderRoute) Run() {
+	_m.Called()
+}
+
+// NewIOrderRoute creates a new instance of IOrderRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderRoute {
+	mock := &IOrderRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
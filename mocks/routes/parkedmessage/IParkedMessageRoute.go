@@ -0,0 +1,29 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// IParkedMessageRoute is an autogenerated mock type for the IParkedMessageRoute type
+type IParkedMessageRoute struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields:
+func (_m *IParkedMessageRoute) Run() {
+	_m.Called()
+}
+
+// NewIParkedMessageRoute creates a new instance of IParkedMessageRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIParkedMessageRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IParkedMessageRoute {
+	mock := &IParkedMessageRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// IVoucherRoute is an autogenerated mock type for the IVoucherRoute type
+type IVoucherRoute struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields:
+func (_m *IVoucherRoute) Run() {
+	_m.Called()
+}
+
+// NewIVoucherRoute creates a new instance of IVoucherRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIVoucherRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IVoucherRoute {
+	mock := &IVoucherRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
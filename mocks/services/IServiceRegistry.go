@@ -3,9 +3,23 @@
 package mocks
 
 import (
+	order "order-service/services/order"
+
 	mock "github.com/stretchr/testify/mock"
 
-	services "order-service/services/suborder"
+	outbox "order-service/services/outbox"
+
+	parkedmessage "order-service/services/parkedmessage"
+
+	paymentreminder "order-service/services/paymentreminder"
+
+	reconciliation "order-service/services/reconciliation"
+
+	services "order-service/services/installmentplan"
+
+	suborder "order-service/services/suborder"
+
+	voucher "order-service/services/voucher"
 )
 
 // IServiceRegistry is an autogenerated mock type for the IServiceRegistry type
@@ -13,16 +27,128 @@ type IServiceRegistry struct {
 	mock.Mock
 }
 
+// GetInstallmentPlan provides a mock function with given fields:
+func (_m *IServiceRegistry) GetInstallmentPlan() services.IInstallmentPlanService {
+	ret := _m.Called()
+
+	var r0 services.IInstallmentPlanService
+	if rf, ok := ret.Get(0).(func() services.IInstallmentPlanService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.IInstallmentPlanService)
+		}
+	}
+
+	return r0
+}
+
+// GetOrder provides a mock function with given fields:
+func (_m *IServiceRegistry) GetOrder() order.IOrderService {
+	ret := _m.Called()
+
+	var r0 order.IOrderService
+	if rf, ok := ret.Get(0).(func() order.IOrderService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(order.IOrderService)
+		}
+	}
+
+	return r0
+}
+
+// GetOutbox provides a mock function with given fields:
+func (_m *IServiceRegistry) GetOutbox() outbox.IOutboxService {
+	ret := _m.Called()
+
+	var r0 outbox.IOutboxService
+	if rf, ok := ret.Get(0).(func() outbox.IOutboxService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.IOutboxService)
+		}
+	}
+
+	return r0
+}
+
+// GetParkedMessage provides a mock function with given fields:
+func (_m *IServiceRegistry) GetParkedMessage() parkedmessage.IParkedMessageService {
+	ret := _m.Called()
+
+	var r0 parkedmessage.IParkedMessageService
+	if rf, ok := ret.Get(0).(func() parkedmessage.IParkedMessageService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(parkedmessage.IParkedMessageService)
+		}
+	}
+
+	return r0
+}
+
+// GetPaymentReminder provides a mock function with given fields:
+func (_m *IServiceRegistry) GetPaymentReminder() paymentreminder.IPaymentReminderService {
+	ret := _m.Called()
+
+	var r0 paymentreminder.IPaymentReminderService
+	if rf, ok := ret.Get(0).(func() paymentreminder.IPaymentReminderService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(paymentreminder.IPaymentReminderService)
+		}
+	}
+
+	return r0
+}
+
+// GetReconciliation provides a mock function with given fields:
+func (_m *IServiceRegistry) GetReconciliation() reconciliation.IReconciliationService {
+	ret := _m.Called()
+
+	var r0 reconciliation.IReconciliationService
+	if rf, ok := ret.Get(0).(func() reconciliation.IReconciliationService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reconciliation.IReconciliationService)
+		}
+	}
+
+	return r0
+}
+
 // GetSubOrder provides a mock function with given fields:
-func (_m *IServiceRegistry) GetSubOrder() services.ISubOrderService {
+func (_m *IServiceRegistry) GetSubOrder() suborder.ISubOrderService {
+	ret := _m.Called()
+
+	var r0 suborder.ISubOrderService
+	if rf, ok := ret.Get(0).(func() suborder.ISubOrderService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(suborder.ISubOrderService)
+		}
+	}
+
+	return r0
+}
+
+// GetVoucher provides a mock function with given fields:
+func (_m *IServiceRegistry) GetVoucher() voucher.IVoucherService {
 	ret := _m.Called()
 
-	var r0 services.ISubOrderService
-	if rf, ok := ret.Get(0).(func() services.ISubOrderService); ok {
+	var r0 voucher.IVoucherService
+	if rf, ok := ret.Get(0).(func() voucher.IVoucherService); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(services.ISubOrderService)
+			r0 = ret.Get(0).(voucher.IVoucherService)
 		}
 	}
 
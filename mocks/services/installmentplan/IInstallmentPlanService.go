@@ -0,0 +1,236 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	constant "order-service/constant"
+
+	dto "order-service/domain/dto/installmentplan"
+
+	helper "order-service/utils/helper"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+
+	money "order-service/common/money"
+
+	time "time"
+)
+
+// IInstallmentPlanService is an autogenerated mock type for the IInstallmentPlanService type
+type IInstallmentPlanService struct {
+	mock.Mock
+}
+
+// AssignPackage provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IInstallmentPlanService) AssignPackage(_a0 context.Context, _a1 string, _a2 *dto.AssignPackageRequest) (*dto.InstallmentPlanResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.InstallmentPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.AssignPackageRequest) (*dto.InstallmentPlanResponse, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.AssignPackageRequest) *dto.InstallmentPlanResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InstallmentPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dto.AssignPackageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInstallmentPlan provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) CreateInstallmentPlan(_a0 context.Context, _a1 *dto.InstallmentPlanRequest) (*dto.InstallmentPlanResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.InstallmentPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequest) (*dto.InstallmentPlanResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequest) *dto.InstallmentPlanResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InstallmentPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.InstallmentPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindItem provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) FindItem(_a0 *models.InstallmentPlan, _a1 constant.PaymentType) *models.InstallmentPlanItem {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlanItem
+	if rf, ok := ret.Get(0).(func(*models.InstallmentPlan, constant.PaymentType) *models.InstallmentPlanItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlanItem)
+		}
+	}
+
+	return r0
+}
+
+// GetInstallmentPlanList provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) GetInstallmentPlanList(_a0 context.Context, _a1 *dto.InstallmentPlanRequestParam) (*helper.PaginationResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *helper.PaginationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequestParam) (*helper.PaginationResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.InstallmentPlanRequestParam) *helper.PaginationResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helper.PaginationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.InstallmentPlanRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextItem provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) NextItem(_a0 *models.InstallmentPlan, _a1 []models.SubOrder) *models.InstallmentPlanItem {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlanItem
+	if rf, ok := ret.Get(0).(func(*models.InstallmentPlan, []models.SubOrder) *models.InstallmentPlanItem); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlanItem)
+		}
+	}
+
+	return r0
+}
+
+// ResolveByOrder provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) ResolveByOrder(_a0 context.Context, _a1 *models.Order) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Order) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Order) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Order) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveByPackageID provides a mock function with given fields: _a0, _a1
+func (_m *IInstallmentPlanService) ResolveByPackageID(_a0 context.Context, _a1 string) (*models.InstallmentPlan, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *models.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.InstallmentPlan, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.InstallmentPlan); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validate provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *IInstallmentPlanService) Validate(_a0 context.Context, _a1 *models.InstallmentPlan, _a2 []models.SubOrder, _a3 money.Money, _a4 *dto.ValidateInstallmentRequest) (*models.InstallmentPlanItem, *time.Time, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *models.InstallmentPlanItem
+	var r1 *time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.InstallmentPlan, []models.SubOrder, money.Money, *dto.ValidateInstallmentRequest) (*models.InstallmentPlanItem, *time.Time, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.InstallmentPlan, []models.SubOrder, money.Money, *dto.ValidateInstallmentRequest) *models.InstallmentPlanItem); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InstallmentPlanItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.InstallmentPlan, []models.SubOrder, money.Money, *dto.ValidateInstallmentRequest) *time.Time); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *models.InstallmentPlan, []models.SubOrder, money.Money, *dto.ValidateInstallmentRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewIInstallmentPlanService creates a new instance of IInstallmentPlanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIInstallmentPlanService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IInstallmentPlanService {
+	mock := &IInstallmentPlanService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/order"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IOrderService is an autogenerated mock type for the IOrderService type
+type IOrderService struct {
+	mock.Mock
+}
+
+// GetOrderDetail provides a mock function with given fields: _a0, _a1
+func (_m *IOrderService) GetOrderDetail(_a0 context.Context, _a1 string) (*dto.OrderResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.OrderResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.OrderResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIOrderService creates a new instance of IOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderService {
+	mock := &IOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "order-service/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IOutboxService is an autogenerated mock type for the IOutboxService type
+type IOutboxService struct {
+	mock.Mock
+}
+
+// Dispatch provides a mock function with given fields: _a0, _a1
+func (_m *IOutboxService) Dispatch(_a0 context.Context, _a1 *models.OrderOutbox) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.OrderOutbox) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DispatchPending provides a mock function with given fields: _a0
+func (_m *IOutboxService) DispatchPending(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIOutboxService creates a new instance of IOutboxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOutboxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOutboxService {
+	mock := &IOutboxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
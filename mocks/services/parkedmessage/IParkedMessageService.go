@@ -0,0 +1,98 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/parkedmessage"
+	helper "order-service/utils/helper"
+
+	mock "github.com/stretchr/testify/mock"
+
+	services "order-service/services/parkedmessage"
+)
+
+// IParkedMessageService is an autogenerated mock type for the IParkedMessageService type
+type IParkedMessageService struct {
+	mock.Mock
+}
+
+// GetParkedMessageList provides a mock function with given fields: _a0, _a1
+func (_m *IParkedMessageService) GetParkedMessageList(_a0 context.Context, _a1 *dto.ParkedMessageRequestParam) (*helper.PaginationResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *helper.PaginationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ParkedMessageRequestParam) (*helper.PaginationResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ParkedMessageRequestParam) *helper.PaginationResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helper.PaginationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ParkedMessageRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Park provides a mock function with given fields: _a0, _a1
+func (_m *IParkedMessageService) Park(_a0 context.Context, _a1 *dto.ParkedMessageRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ParkedMessageRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Replay provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IParkedMessageService) Replay(_a0 context.Context, _a1 string, _a2 services.ReplayHandlerResolver) (*dto.ParkedMessageResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.ParkedMessageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ReplayHandlerResolver) (*dto.ParkedMessageResponse, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ReplayHandlerResolver) *dto.ParkedMessageResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ParkedMessageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, services.ReplayHandlerResolver) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIParkedMessageService creates a new instance of IParkedMessageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIParkedMessageService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IParkedMessageService {
+	mock := &IParkedMessageService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,45 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	sarama "github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReplayHandlerResolver is an autogenerated mock type for the ReplayHandlerResolver type
+type ReplayHandlerResolver struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: topic
+func (_m *ReplayHandlerResolver) Execute(topic string) func(context.Context, *sarama.ConsumerMessage) error {
+	ret := _m.Called(topic)
+
+	var r0 func(context.Context, *sarama.ConsumerMessage) error
+	if rf, ok := ret.Get(0).(func(string) func(context.Context, *sarama.ConsumerMessage) error); ok {
+		r0 = rf(topic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(context.Context, *sarama.ConsumerMessage) error)
+		}
+	}
+
+	return r0
+}
+
+// NewReplayHandlerResolver creates a new instance of ReplayHandlerResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReplayHandlerResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReplayHandlerResolver {
+	mock := &ReplayHandlerResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
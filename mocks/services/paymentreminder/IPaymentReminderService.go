@@ -0,0 +1,52 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPaymentReminderService is an autogenerated mock type for the IPaymentReminderService type
+type IPaymentReminderService struct {
+	mock.Mock
+}
+
+// SendDueReminders provides a mock function with given fields: _a0
+func (_m *IPaymentReminderService) SendDueReminders(_a0 context.Context) (int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIPaymentReminderService creates a new instance of IPaymentReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentReminderService {
+	mock := &IPaymentReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/reconciliation"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IReconciliationService is an autogenerated mock type for the IReconciliationService type
+type IReconciliationService struct {
+	mock.Mock
+}
+
+// Reconcile provides a mock function with given fields: _a0, _a1
+func (_m *IReconciliationService) Reconcile(_a0 context.Context, _a1 *dto.ReconciliationRequest) (*dto.ReconciliationReport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.ReconciliationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ReconciliationRequest) (*dto.ReconciliationReport, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ReconciliationRequest) *dto.ReconciliationReport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ReconciliationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ReconciliationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIReconciliationService creates a new instance of IReconciliationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIReconciliationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IReconciliationService {
+	mock := &IReconciliationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,8 @@ import (
 	helper "order-service/utils/helper"
 
 	mock "github.com/stretchr/testify/mock"
+
+	orderrefund "order-service/domain/dto/orderrefund"
 )
 
 // ISubOrderService is an autogenerated mock type for the ISubOrderService type
@@ -29,6 +31,20 @@ func (_m *ISubOrderService) Cancel(_a0 context.Context, _a1 string) error {
 	return r0
 }
 
+// CancelMyOrder provides a mock function with given fields: _a0, _a1
+func (_m *ISubOrderService) CancelMyOrder(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateOrder provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderService) CreateOrder(_a0 context.Context, _a1 *dto.SubOrderRequest) (*dto.SubOrderResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -55,6 +71,82 @@ func (_m *ISubOrderService) CreateOrder(_a0 context.Context, _a1 *dto.SubOrderRe
 	return r0, r1
 }
 
+// ExpireOverdue provides a mock function with given fields: _a0
+func (_m *ISubOrderService) ExpireOverdue(_a0 context.Context) (int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyOrderDetail provides a mock function with given fields: _a0, _a1
+func (_m *ISubOrderService) GetMyOrderDetail(_a0 context.Context, _a1 string) (*dto.SubOrderResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.SubOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.SubOrderResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.SubOrderResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SubOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyOrderList provides a mock function with given fields: _a0, _a1
+func (_m *ISubOrderService) GetMyOrderList(_a0 context.Context, _a1 *dto.SubOrderRequestParam) (*helper.PaginationResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *helper.PaginationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam) (*helper.PaginationResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam) *helper.PaginationResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helper.PaginationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SubOrderRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrderDetail provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderService) GetOrderDetail(_a0 context.Context, _a1 string) (*dto.SubOrderResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -107,6 +199,32 @@ func (_m *ISubOrderService) GetSubOrderList(_a0 context.Context, _a1 *dto.SubOrd
 	return r0, r1
 }
 
+// GetSubOrderListByCursor provides a mock function with given fields: _a0, _a1
+func (_m *ISubOrderService) GetSubOrderListByCursor(_a0 context.Context, _a1 *dto.SubOrderRequestParam) (*helper.CursorPaginationResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *helper.CursorPaginationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam) (*helper.CursorPaginationResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubOrderRequestParam) *helper.CursorPaginationResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helper.CursorPaginationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SubOrderRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReceivePaymentExpire provides a mock function with given fields: _a0, _a1
 func (_m *ISubOrderService) ReceivePaymentExpire(_a0 context.Context, _a1 *dto.PaymentRequest) error {
 	ret := _m.Called(_a0, _a1)
@@ -149,6 +267,58 @@ func (_m *ISubOrderService) ReceivePendingPayment(_a0 context.Context, _a1 *dto.
 	return r0
 }
 
+// Refund provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ISubOrderService) Refund(_a0 context.Context, _a1 string, _a2 *orderrefund.RefundRequest) (*orderrefund.RefundResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *orderrefund.RefundResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderrefund.RefundRequest) (*orderrefund.RefundResponse, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderrefund.RefundRequest) *orderrefund.RefundResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderrefund.RefundResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *orderrefund.RefundRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegeneratePaymentLink provides a mock function with given fields: _a0, _a1
+func (_m *ISubOrderService) RegeneratePaymentLink(_a0 context.Context, _a1 string) (*dto.SubOrderResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.SubOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.SubOrderResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.SubOrderResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SubOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewISubOrderService creates a new instance of ISubOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewISubOrderService(t interface {
@@ -0,0 +1,216 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	dto "order-service/domain/dto/voucher"
+
+	gorm "gorm.io/gorm"
+
+	helper "order-service/utils/helper"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "order-service/domain/models"
+
+	money "order-service/common/money"
+)
+
+// IVoucherService is an autogenerated mock type for the IVoucherService type
+type IVoucherService struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherService) Apply(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.ApplyVoucherRequest) (*models.Voucher, money.Money, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *models.Voucher
+	var r1 money.Money
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.ApplyVoucherRequest) (*models.Voucher, money.Money, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.ApplyVoucherRequest) *models.Voucher); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, *dto.ApplyVoucherRequest) money.Money); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Get(1).(money.Money)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *gorm.DB, *dto.ApplyVoucherRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateVoucher provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherService) CreateVoucher(_a0 context.Context, _a1 *dto.VoucherRequest) (*dto.VoucherResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.VoucherResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequest) (*dto.VoucherResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequest) *dto.VoucherResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.VoucherResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.VoucherRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVoucher provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherService) DeleteVoucher(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetVoucherDetail provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherService) GetVoucherDetail(_a0 context.Context, _a1 string) (*dto.VoucherResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.VoucherResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.VoucherResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.VoucherResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.VoucherResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherList provides a mock function with given fields: _a0, _a1
+func (_m *IVoucherService) GetVoucherList(_a0 context.Context, _a1 *dto.VoucherRequestParam) (*helper.PaginationResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *helper.PaginationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequestParam) (*helper.PaginationResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.VoucherRequestParam) *helper.PaginationResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helper.PaginationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.VoucherRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeem provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherService) Redeem(_a0 context.Context, _a1 *gorm.DB, _a2 *dto.RedeemVoucherRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, *dto.RedeemVoucherRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Release provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherService) Release(_a0 context.Context, _a1 *gorm.DB, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVoucher provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IVoucherService) UpdateVoucher(_a0 context.Context, _a1 string, _a2 *dto.VoucherRequest) (*dto.VoucherResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *dto.VoucherResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.VoucherRequest) (*dto.VoucherResponse, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.VoucherRequest) *dto.VoucherResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.VoucherResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dto.VoucherRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIVoucherService creates a new instance of IVoucherService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIVoucherService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IVoucherService {
+	mock := &IVoucherService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
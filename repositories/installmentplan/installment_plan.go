@@ -0,0 +1,258 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	installmentPlanModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
+	installmentPlanDTO "order-service/domain/dto/installmentplan"
+	errorHelper "order-service/utils/error"
+)
+
+type IInstallmentPlan struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IInstallmentPlanRepository interface {
+	Create(
+		context.Context,
+		*gorm.DB,
+		*installmentPlanDTO.InstallmentPlanRequest,
+	) (*installmentPlanModel.InstallmentPlan, error)
+	FindAllWithPagination(
+		context.Context,
+		*installmentPlanDTO.InstallmentPlanRequestParam,
+	) ([]installmentPlanModel.InstallmentPlan, int64, error)
+	FindOneByUUID(context.Context, string) (*installmentPlanModel.InstallmentPlan, error)
+	FindOneByID(context.Context, uint) (*installmentPlanModel.InstallmentPlan, error)
+	FindOneByPackageID(context.Context, string) (*installmentPlanModel.InstallmentPlan, error)
+	FindDefault(context.Context) (*installmentPlanModel.InstallmentPlan, error)
+	ClearDefault(context.Context, *gorm.DB) error
+	AssignPackage(context.Context, *gorm.DB, string, uint) error
+}
+
+func NewInstallmentPlan(db *gorm.DB, sentry sentry.ISentry) IInstallmentPlanRepository {
+	return &IInstallmentPlan{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+func (o *IInstallmentPlan) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *installmentPlanDTO.InstallmentPlanRequest,
+) (*installmentPlanModel.InstallmentPlan, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.Create"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	items := make([]installmentPlanModel.InstallmentPlanItem, 0, len(request.Items))
+	for i, item := range request.Items {
+		items = append(items, installmentPlanModel.InstallmentPlanItem{
+			Sequence:           i + 1,
+			PaymentType:        item.PaymentType,
+			Title:              item.Title,
+			IndonesianTitle:    item.IndonesianTitle,
+			AmountType:         item.AmountType,
+			Value:              item.Value,
+			Amount:             item.Amount,
+			IsOptional:         item.IsOptional,
+			DueDateOffsetInDay: item.DueDateOffsetInDay,
+			CreatedAt:          &datetime,
+			UpdatedAt:          &datetime,
+		})
+	}
+
+	installmentPlan := installmentPlanModel.InstallmentPlan{
+		UUID:        uuid.New(),
+		Name:        request.Name,
+		Description: request.Description,
+		IsDefault:   request.IsDefault,
+		CreatedAt:   &datetime,
+		UpdatedAt:   &datetime,
+		Items:       items,
+	}
+	err := tx.WithContext(ctx).Create(&installmentPlan).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &installmentPlan, nil
+}
+
+func (o *IInstallmentPlan) FindAllWithPagination(
+	ctx context.Context,
+	request *installmentPlanDTO.InstallmentPlanRequestParam,
+) ([]installmentPlanModel.InstallmentPlan, int64, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.FindAllWithPagination"
+	var (
+		span             = o.sentry.StartSpan(ctx, logCtx)
+		installmentPlans []installmentPlanModel.InstallmentPlan
+		total            int64
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	limit := request.Limit
+	offset := (request.Page - 1) * limit
+	err := o.db.WithContext(ctx).
+		Preload("Items", o.orderBySequence).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&installmentPlans).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	err = o.db.WithContext(ctx).
+		Model(&installmentPlanModel.InstallmentPlan{}).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	return installmentPlans, total, nil
+}
+
+func (o *IInstallmentPlan) FindOneByUUID(
+	ctx context.Context,
+	planUUID string,
+) (*installmentPlanModel.InstallmentPlan, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.FindOneByUUID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).Where("uuid = ?", planUUID))
+}
+
+func (o *IInstallmentPlan) FindOneByID(
+	ctx context.Context,
+	id uint,
+) (*installmentPlanModel.InstallmentPlan, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.FindOneByID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).Unscoped().Where("id = ?", id))
+}
+
+func (o *IInstallmentPlan) FindOneByPackageID(
+	ctx context.Context,
+	packageID string,
+) (*installmentPlanModel.InstallmentPlan, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.FindOneByPackageID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).
+		Where("id = (?)", o.db.Model(&installmentPlanModel.PackageInstallmentPlan{}).
+			Select("installment_plan_id").
+			Where("package_id = ?", packageID)))
+}
+
+func (o *IInstallmentPlan) FindDefault(ctx context.Context) (*installmentPlanModel.InstallmentPlan, error) {
+	const logCtx = "repositories.installmentplan.installment_plan.FindDefault"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).Where("is_default = ?", true))
+}
+
+func (o *IInstallmentPlan) ClearDefault(ctx context.Context, tx *gorm.DB) error {
+	const logCtx = "repositories.installmentplan.installment_plan.ClearDefault"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Model(&installmentPlanModel.InstallmentPlan{}).
+		Where("is_default = ?", true).
+		Update("is_default", false).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+func (o *IInstallmentPlan) AssignPackage(
+	ctx context.Context,
+	tx *gorm.DB,
+	packageID string,
+	installmentPlanID uint,
+) error {
+	const logCtx = "repositories.installmentplan.installment_plan.AssignPackage"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "package_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"installment_plan_id", "updated_at"}),
+		}).
+		Create(&installmentPlanModel.PackageInstallmentPlan{
+			PackageID:         packageID,
+			InstallmentPlanID: installmentPlanID,
+			CreatedAt:         &datetime,
+			UpdatedAt:         &datetime,
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+func (o *IInstallmentPlan) findOne(query *gorm.DB) (*installmentPlanModel.InstallmentPlan, error) {
+	var installmentPlan installmentPlanModel.InstallmentPlan
+	err := query.
+		Preload("Items", o.orderBySequence).
+		First(&installmentPlan).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrInstallmentPlanNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &installmentPlan, nil
+}
+
+func (o *IInstallmentPlan) orderBySequence(db *gorm.DB) *gorm.DB {
+	return db.Order("sequence ASC")
+}
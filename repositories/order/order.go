@@ -13,7 +13,9 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"order-service/common/money"
 	errorGeneral "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
 	orderDTO "order-service/domain/dto/order"
 	orderModel "order-service/domain/models"
 	errorHelper "order-service/utils/error"
@@ -29,8 +31,12 @@ type IOrderRepository interface {
 	DeleteByOrderID(context.Context, *gorm.DB, uint) error
 	FindOneOrderByUUID(context.Context, uuid.UUID) (*orderModel.Order, error)
 	FindOneOrderByID(context.Context, uint) (*orderModel.Order, error)
+	FindOneOrderByIDWithLocking(context.Context, *gorm.DB, uint) (*orderModel.Order, error)
+	Restore(context.Context, *gorm.DB, uint) error
+	FindOneAggregateByUUID(context.Context, string) (*orderModel.Order, error)
 	FindOneOrderByCustomerIDWithLocking(context.Context, *gorm.DB, uuid.UUID) (*orderModel.Order, error)
 	Update(ctx context.Context, db *gorm.DB, request *orderDTO.OrderRequest) error
+	AddRemainingOutstandingAmount(context.Context, *gorm.DB, uint, money.Money) error
 }
 
 func NewOrder(db *gorm.DB, sentry sentry.ISentry) IOrderRepository {
@@ -119,6 +125,62 @@ func (o *IOrder) FindOneOrderByID(
 	return &order, nil
 }
 
+// FindOneOrderByIDWithLocking also returns a deleted order, so a cancelled order can be restored.
+func (o *IOrder) FindOneOrderByIDWithLocking(
+	ctx context.Context,
+	tx *gorm.DB,
+	id uint,
+) (*orderModel.Order, error) {
+	const logCtx = "repositories.order.order.FindOneOrderByIDWithLocking"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order orderModel.Order
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Unscoped().
+		Where("id = ?", id).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrOrderNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &order, nil
+}
+
+func (o *IOrder) FindOneAggregateByUUID(ctx context.Context, orderUUID string) (*orderModel.Order, error) {
+	const logCtx = "repositories.order.order.FindOneAggregateByUUID"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order orderModel.Order
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Preload("SubOrder", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("SubOrder.Payment", "superseded_at IS NULL").
+		Preload("SubOrder.Histories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Where("uuid = ?", orderUUID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrOrderNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &order, nil
+}
+
 func (o *IOrder) Create(ctx context.Context, tx *gorm.DB, request *orderDTO.OrderRequest) (*orderModel.Order, error) {
 	const logCtx = "repositories.order.order.Create"
 	var (
@@ -138,7 +200,18 @@ func (o *IOrder) Create(ctx context.Context, tx *gorm.DB, request *orderDTO.Orde
 	order = orderModel.Order{
 		UUID:                       uuid.New(),
 		OrderName:                  *orderName,
+		PackagePrice:               request.PackagePrice,
+		Currency:                   request.Currency,
+		Locale:                     request.Locale,
+		PromoID:                    request.PromoID,
+		PromoName:                  request.PromoName,
+		DiscountAmount:             request.DiscountAmount,
+		VoucherID:                  request.VoucherID,
+		VoucherCode:                request.VoucherCode,
+		VoucherDiscountAmount:      request.VoucherDiscountAmount,
+		TotalAmount:                request.TotalAmount,
 		RemainingOutstandingAmount: request.RemainingOutstandingAmount,
+		InstallmentPlanID:          request.InstallmentPlanID,
 		CustomerID:                 request.CustomerID,
 		CustomerName:               request.CustomerName,
 		CustomerEmail:              request.CustomerEmail,
@@ -166,7 +239,7 @@ func (o *IOrder) DeleteByOrderID(ctx context.Context, tx *gorm.DB, orderID uint)
 
 	err := tx.WithContext(ctx).
 		Model(&orderModel.Order{}).
-		Where("uuid = ?", orderID).
+		Where("id = ?", orderID).
 		Delete(&orderModel.Order{}).Error
 	if err != nil {
 		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
@@ -174,6 +247,25 @@ func (o *IOrder) DeleteByOrderID(ctx context.Context, tx *gorm.DB, orderID uint)
 	return nil
 }
 
+func (o *IOrder) Restore(ctx context.Context, tx *gorm.DB, orderID uint) error {
+	const logCtx = "repositories.order.order.Restore"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Unscoped().
+		Model(&orderModel.Order{}).
+		Where("id = ?", orderID).
+		Update("deleted_at", nil).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
 func (o *IOrder) Update(ctx context.Context, tx *gorm.DB, request *orderDTO.OrderRequest) error {
 	const logCtx = "repositories.order.order.Update"
 	var (
@@ -195,6 +287,24 @@ func (o *IOrder) Update(ctx context.Context, tx *gorm.DB, request *orderDTO.Orde
 	return nil
 }
 
+func (o *IOrder) AddRemainingOutstandingAmount(ctx context.Context, tx *gorm.DB, orderID uint, amount money.Money) error {
+	const logCtx = "repositories.order.order.AddRemainingOutstandingAmount"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Model(&orderModel.Order{}).
+		Where("id = ?", orderID).
+		Update("remaining_outstanding_amount", gorm.Expr("remaining_outstanding_amount + ?", amount)).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
 func (o *IOrder) autoNumber(ctx context.Context) (*string, error) {
 	var (
 		order  *orderModel.Order
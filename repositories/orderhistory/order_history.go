@@ -21,6 +21,7 @@ type IOrderHistory struct {
 type IOrderHistoryRepository interface {
 	Create(context.Context, *gorm.DB, *orderHistoryDTO.OrderHistoryRequest) error
 	BulkCreate(context.Context, *gorm.DB, []orderHistoryDTO.OrderHistoryRequest) error
+	FindLatestBySubOrderID(context.Context, *gorm.DB, uint) (*orderHistoryModel.OrderHistory, error)
 }
 
 func NewOrderHistory(db *gorm.DB, sentry sentry.ISentry) IOrderHistoryRepository {
@@ -88,3 +89,26 @@ func (o *IOrderHistory) BulkCreate(
 
 	return nil
 }
+
+func (o *IOrderHistory) FindLatestBySubOrderID(
+	ctx context.Context,
+	tx *gorm.DB,
+	subOrderID uint,
+) (*orderHistoryModel.OrderHistory, error) {
+	const logCtx = "repositories.orderhistory.order_history.FindLatestBySubOrderID"
+	var (
+		span         = o.sentry.StartSpan(ctx, logCtx)
+		orderHistory orderHistoryModel.OrderHistory
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Where("sub_order_id = ?", subOrderID).
+		Order("id DESC").
+		First(&orderHistory).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &orderHistory, nil
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"order-service/common/sentry"
 	orderInvoiceModel "order-service/domain/models"
@@ -21,6 +22,8 @@ type IOrderInvoice struct {
 
 type IOrderInvoiceRepository interface {
 	Create(context.Context, *gorm.DB, *orderInvoiceModel.OrderInvoice) error
+	FindBySubOrderID(context.Context, uint) (*orderInvoiceModel.OrderInvoice, error)
+	FindAllBySubOrderIDs(context.Context, []uint) ([]orderInvoiceModel.OrderInvoice, error)
 }
 
 func NewOrderInvoice(db *gorm.DB, sentry sentry.ISentry) IOrderInvoiceRepository {
@@ -60,3 +63,54 @@ func (o *IOrderInvoice) Create(
 	}
 	return nil
 }
+
+func (o *IOrderInvoice) FindBySubOrderID(
+	ctx context.Context,
+	subOrderID uint,
+) (*orderInvoiceModel.OrderInvoice, error) {
+	const logCtx = "repositories.orderinvoice.order_invoice.FindBySubOrderID"
+	var (
+		span         = o.sentry.StartSpan(ctx, logCtx)
+		orderInvoice orderInvoiceModel.OrderInvoice
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Where("sub_order_id = ?", subOrderID).
+		Order("id DESC").
+		First(&orderInvoice).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &orderInvoice, nil
+}
+
+func (o *IOrderInvoice) FindAllBySubOrderIDs(
+	ctx context.Context,
+	subOrderIDs []uint,
+) ([]orderInvoiceModel.OrderInvoice, error) {
+	const logCtx = "repositories.orderinvoice.order_invoice.FindAllBySubOrderIDs"
+	var (
+		span          = o.sentry.StartSpan(ctx, logCtx)
+		orderInvoices []orderInvoiceModel.OrderInvoice
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	if len(subOrderIDs) == 0 {
+		return orderInvoices, nil
+	}
+
+	err := o.db.WithContext(ctx).
+		Where("sub_order_id IN ?", subOrderIDs).
+		Order("id ASC").
+		Find(&orderInvoices).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return orderInvoices, nil
+}
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	"order-service/constant"
+	orderOutboxModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	outboxDTO "order-service/domain/dto/outbox"
+	errorHelper "order-service/utils/error"
+)
+
+type IOrderOutbox struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IOrderOutboxRepository interface {
+	Create(context.Context, *gorm.DB, *outboxDTO.OutboxRequest) (*orderOutboxModel.OrderOutbox, error)
+	FindAllDue(context.Context, int) ([]orderOutboxModel.OrderOutbox, error)
+	Claim(context.Context, uint, time.Time) (bool, error)
+	Update(context.Context, *gorm.DB, *outboxDTO.UpdateOutboxRequest) error
+}
+
+func NewOrderOutbox(db *gorm.DB, sentry sentry.ISentry) IOrderOutboxRepository {
+	return &IOrderOutbox{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+func (o *IOrderOutbox) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *outboxDTO.OutboxRequest,
+) (*orderOutboxModel.OrderOutbox, error) {
+	const logCtx = "repositories.orderoutbox.order_outbox.Create"
+	var (
+		span        = o.sentry.StartSpan(ctx, logCtx)
+		orderOutbox orderOutboxModel.OrderOutbox
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	payload, err := json.Marshal(request.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	orderOutbox = orderOutboxModel.OrderOutbox{
+		UUID:           uuid.New(),
+		SubOrderID:     request.SubOrderID,
+		Event:          request.Event,
+		IdempotencyKey: request.IdempotencyKey,
+		Payload:        string(payload),
+		Status:         constant.OutboxPending,
+		NextAttemptAt:  datetime,
+		CreatedAt:      &datetime,
+		UpdatedAt:      &datetime,
+	}
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "idempotency_key"}},
+			DoNothing: true,
+		}).
+		Create(&orderOutbox)
+	if result.Error != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &orderOutbox, nil
+}
+
+func (o *IOrderOutbox) FindAllDue(ctx context.Context, limit int) ([]orderOutboxModel.OrderOutbox, error) {
+	const logCtx = "repositories.orderoutbox.order_outbox.FindAllDue"
+	var (
+		span          = o.sentry.StartSpan(ctx, logCtx)
+		orderOutboxes []orderOutboxModel.OrderOutbox
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Where("status IN ?", []constant.OutboxStatus{constant.OutboxPending, constant.OutboxProcessing}).
+		Where("next_attempt_at <= ?", time.Now()).
+		Order("id ASC").
+		Limit(limit).
+		Find(&orderOutboxes).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return orderOutboxes, nil
+}
+
+// Claim marks the outbox entry as processing until lockedUntil, it returns false when
+// another dispatcher already owns the entry so the caller must skip it.
+func (o *IOrderOutbox) Claim(ctx context.Context, id uint, lockedUntil time.Time) (bool, error) {
+	const logCtx = "repositories.orderoutbox.order_outbox.Claim"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	result := o.db.WithContext(ctx).
+		Model(&orderOutboxModel.OrderOutbox{}).
+		Where("id = ?", id).
+		Where("status IN ?", []constant.OutboxStatus{constant.OutboxPending, constant.OutboxProcessing}).
+		Where("next_attempt_at <= ?", time.Now()).
+		Updates(map[string]interface{}{
+			"status":          constant.OutboxProcessing,
+			"next_attempt_at": lockedUntil,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (o *IOrderOutbox) Update(ctx context.Context, tx *gorm.DB, request *outboxDTO.UpdateOutboxRequest) error {
+	const logCtx = "repositories.orderoutbox.order_outbox.Update"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Model(&orderOutboxModel.OrderOutbox{}).
+		Where("id = ?", request.ID).
+		Updates(map[string]interface{}{
+			"status":          request.Status,
+			"attempts":        request.Attempts,
+			"next_attempt_at": request.NextAttemptAt,
+			"last_error":      request.LastError,
+			"processed_at":    request.ProcessedAt,
+			"updated_at":      time.Now(),
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
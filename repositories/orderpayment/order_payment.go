@@ -2,11 +2,13 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"order-service/common/sentry"
+	"order-service/constant"
 	orderPaymentModel "order-service/domain/models"
 
 	"time"
@@ -25,6 +27,10 @@ type IOrderPaymentRepository interface {
 	Create(context.Context, *gorm.DB, *orderPaymentDTO.OrderPaymentRequest) error
 	Update(context.Context, *gorm.DB, *orderPaymentDTO.OrderPaymentRequest) error
 	FindByPaymentID(context.Context, *gorm.DB, string) (*orderPaymentModel.OrderPayment, error)
+	FindBySubOrderID(context.Context, uint) (*orderPaymentModel.OrderPayment, error)
+	UpdateStatusBySubOrderID(context.Context, *gorm.DB, uint, string) error
+	SupersedeBySubOrderID(context.Context, *gorm.DB, uint) error
+	FindAllUnsettled(context.Context, uint, int) ([]orderPaymentDTO.UnsettledPayment, error)
 }
 
 func NewOrderPayment(db *gorm.DB, sentry sentry.ISentry) IOrderPaymentRepository {
@@ -127,3 +133,112 @@ func (o *IOrderPayment) FindByPaymentID(
 	}
 	return &orderPayment, nil
 }
+
+func (o *IOrderPayment) FindBySubOrderID(
+	ctx context.Context,
+	subOrderID uint,
+) (*orderPaymentModel.OrderPayment, error) {
+	const logCtx = "repositories.orderpayment.order_payment.FindBySubOrderID"
+	var (
+		span         = o.sentry.StartSpan(ctx, logCtx)
+		orderPayment orderPaymentModel.OrderPayment
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Where("sub_order_id = ? AND superseded_at IS NULL", subOrderID).
+		Order("id DESC").
+		First(&orderPayment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &orderPayment, nil
+}
+
+func (o *IOrderPayment) UpdateStatusBySubOrderID(
+	ctx context.Context,
+	tx *gorm.DB,
+	subOrderID uint,
+	status string,
+) error {
+	const logCtx = "repositories.orderpayment.order_payment.UpdateStatusBySubOrderID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Model(&orderPaymentModel.OrderPayment{}).
+		Where("sub_order_id = ? AND superseded_at IS NULL", subOrderID).
+		Update("status", status).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+// SupersedeBySubOrderID keeps the current payment of the sub order as history so a new payment
+// link can take its place.
+func (o *IOrderPayment) SupersedeBySubOrderID(ctx context.Context, tx *gorm.DB, subOrderID uint) error {
+	const logCtx = "repositories.orderpayment.order_payment.SupersedeBySubOrderID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err := tx.WithContext(ctx).
+		Model(&orderPaymentModel.OrderPayment{}).
+		Where("sub_order_id = ? AND superseded_at IS NULL", subOrderID).
+		Updates(map[string]interface{}{
+			"superseded_at": datetime,
+			"updated_at":    datetime,
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+// FindAllUnsettled pages through the current payments that have not reached a final status yet,
+// ordered by id so the caller can continue after the last id it has seen.
+func (o *IOrderPayment) FindAllUnsettled(
+	ctx context.Context,
+	afterID uint,
+	limit int,
+) ([]orderPaymentDTO.UnsettledPayment, error) {
+	const logCtx = "repositories.orderpayment.order_payment.FindAllUnsettled"
+	var (
+		span     = o.sentry.StartSpan(ctx, logCtx)
+		payments []orderPaymentDTO.UnsettledPayment
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Model(&orderPaymentModel.OrderPayment{}).
+		Select(`order_payments.id, order_payments.payment_id, sub_orders.uuid AS sub_order_uuid,
+			sub_orders.status AS sub_order_status, order_payments.status, order_payments.amount`).
+		Joins("JOIN sub_orders ON sub_orders.id = order_payments.sub_order_id AND sub_orders.deleted_at IS NULL").
+		Where("order_payments.id > ?", afterID).
+		Where("order_payments.superseded_at IS NULL").
+		Where("(order_payments.status IS NULL OR order_payments.status NOT IN ?)", []string{
+			constant.PaymentStatusSettlement.String(),
+			constant.PaymentStatusExpire.String(),
+		}).
+		Order("order_payments.id ASC").
+		Limit(limit).
+		Scan(&payments).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return payments, nil
+}
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"order-service/common/sentry"
+	"order-service/constant"
+	orderRefundModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
+	orderRefundDTO "order-service/domain/dto/orderrefund"
+	errorHelper "order-service/utils/error"
+)
+
+type IOrderRefund struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IOrderRefundRepository interface {
+	Create(context.Context, *gorm.DB, *orderRefundDTO.OrderRefundRequest) (*orderRefundModel.OrderRefund, error)
+	FindOneByUUID(context.Context, string) (*orderRefundModel.OrderRefund, error)
+	FindAllBySubOrderID(context.Context, *gorm.DB, uint) ([]orderRefundModel.OrderRefund, error)
+	FindAllSucceededBySubOrderIDs(context.Context, []uint) ([]orderRefundModel.OrderRefund, error)
+	Update(context.Context, *gorm.DB, *orderRefundDTO.UpdateOrderRefundRequest) error
+}
+
+func NewOrderRefund(db *gorm.DB, sentry sentry.ISentry) IOrderRefundRepository {
+	return &IOrderRefund{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+func (o *IOrderRefund) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *orderRefundDTO.OrderRefundRequest,
+) (*orderRefundModel.OrderRefund, error) {
+	const logCtx = "repositories.orderrefund.order_refund.Create"
+	var (
+		span        = o.sentry.StartSpan(ctx, logCtx)
+		orderRefund orderRefundModel.OrderRefund
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	orderRefund = orderRefundModel.OrderRefund{
+		UUID:       uuid.New(),
+		SubOrderID: request.SubOrderID,
+		Amount:     request.Amount,
+		Reason:     request.Reason,
+		Status:     constant.RefundPending,
+		CreatedBy:  request.CreatedBy,
+		CreatedAt:  &datetime,
+		UpdatedAt:  &datetime,
+	}
+	err := tx.WithContext(ctx).Omit("SubOrder").Create(&orderRefund).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &orderRefund, nil
+}
+
+func (o *IOrderRefund) FindOneByUUID(ctx context.Context, refundUUID string) (*orderRefundModel.OrderRefund, error) {
+	const logCtx = "repositories.orderrefund.order_refund.FindOneByUUID"
+	var (
+		span        = o.sentry.StartSpan(ctx, logCtx)
+		orderRefund orderRefundModel.OrderRefund
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Preload("SubOrder").
+		Preload("SubOrder.Order").
+		Where("uuid = ?", refundUUID).
+		First(&orderRefund).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrRefundNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &orderRefund, nil
+}
+
+func (o *IOrderRefund) FindAllBySubOrderID(
+	ctx context.Context,
+	tx *gorm.DB,
+	subOrderID uint,
+) ([]orderRefundModel.OrderRefund, error) {
+	const logCtx = "repositories.orderrefund.order_refund.FindAllBySubOrderID"
+	var (
+		span         = o.sentry.StartSpan(ctx, logCtx)
+		orderRefunds []orderRefundModel.OrderRefund
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Where("sub_order_id = ?", subOrderID).
+		Order("id ASC").
+		Find(&orderRefunds).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return orderRefunds, nil
+}
+
+func (o *IOrderRefund) FindAllSucceededBySubOrderIDs(
+	ctx context.Context,
+	subOrderIDs []uint,
+) ([]orderRefundModel.OrderRefund, error) {
+	const logCtx = "repositories.orderrefund.order_refund.FindAllSucceededBySubOrderIDs"
+	var (
+		span         = o.sentry.StartSpan(ctx, logCtx)
+		orderRefunds []orderRefundModel.OrderRefund
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	if len(subOrderIDs) == 0 {
+		return orderRefunds, nil
+	}
+
+	err := o.db.WithContext(ctx).
+		Where("sub_order_id IN ?", subOrderIDs).
+		Where("status = ?", constant.RefundSucceeded).
+		Order("id ASC").
+		Find(&orderRefunds).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return orderRefunds, nil
+}
+
+func (o *IOrderRefund) Update(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *orderRefundDTO.UpdateOrderRefundRequest,
+) error {
+	const logCtx = "repositories.orderrefund.order_refund.Update"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err := tx.WithContext(ctx).
+		Model(&orderRefundModel.OrderRefund{}).
+		Where("id = ?", request.ID).
+		Updates(orderRefundModel.OrderRefund{
+			RefundID:         request.RefundID,
+			Status:           request.Status,
+			CreditNoteID:     request.CreditNoteID,
+			CreditNoteNumber: request.CreditNoteNumber,
+			CreditNoteURL:    request.CreditNoteURL,
+			RefundedAt:       request.RefundedAt,
+			UpdatedAt:        &datetime,
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
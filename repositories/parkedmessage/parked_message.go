@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	"order-service/constant"
+	parkedMessageModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	parkedMessageDTO "order-service/domain/dto/parkedmessage"
+	errorHelper "order-service/utils/error"
+)
+
+type IParkedMessage struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IParkedMessageRepository interface {
+	Create(context.Context, *gorm.DB, *parkedMessageDTO.ParkedMessageRequest) (*parkedMessageModel.ParkedMessage, error)
+	FindAllWithPagination(
+		context.Context,
+		*parkedMessageDTO.ParkedMessageRequestParam,
+	) ([]parkedMessageModel.ParkedMessage, int64, error)
+	FindOneByUUID(context.Context, string) (*parkedMessageModel.ParkedMessage, error)
+	Update(context.Context, *gorm.DB, *parkedMessageDTO.UpdateParkedMessageRequest) error
+}
+
+func NewParkedMessage(db *gorm.DB, sentry sentry.ISentry) IParkedMessageRepository {
+	return &IParkedMessage{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+func (o *IParkedMessage) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *parkedMessageDTO.ParkedMessageRequest,
+) (*parkedMessageModel.ParkedMessage, error) {
+	const logCtx = "repositories.parkedmessage.parked_message.Create"
+	var (
+		span          = o.sentry.StartSpan(ctx, logCtx)
+		parkedMessage parkedMessageModel.ParkedMessage
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	headers, err := json.Marshal(request.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	parkedMessage = parkedMessageModel.ParkedMessage{
+		UUID:             uuid.New(),
+		Topic:            request.Topic,
+		Partition:        request.Partition,
+		Offset:           request.Offset,
+		Key:              request.Key,
+		Value:            request.Value,
+		Headers:          string(headers),
+		Error:            request.Error,
+		RetryCount:       request.RetryCount,
+		Status:           constant.ParkedMessageParked,
+		MessageTimestamp: request.MessageTimestamp,
+		FailedAt:         request.FailedAt,
+		CreatedAt:        &datetime,
+		UpdatedAt:        &datetime,
+	}
+	// a message that failed to reach the dead letter topic is delivered again and parked once more,
+	// it keeps the row of its first attempt
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "topic"}, {Name: "partition"}, {Name: "offset"}},
+			DoNothing: true,
+		}).
+		Create(&parkedMessage)
+	if result.Error != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &parkedMessage, nil
+}
+
+func (o *IParkedMessage) FindAllWithPagination(
+	ctx context.Context,
+	request *parkedMessageDTO.ParkedMessageRequestParam,
+) ([]parkedMessageModel.ParkedMessage, int64, error) {
+	const logCtx = "repositories.parkedmessage.parked_message.FindAllWithPagination"
+	var (
+		span           = o.sentry.StartSpan(ctx, logCtx)
+		parkedMessages []parkedMessageModel.ParkedMessage
+		total          int64
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	query := o.db.WithContext(ctx).Model(&parkedMessageModel.ParkedMessage{})
+	if request.Topic != "" {
+		query = query.Where("topic = ?", request.Topic)
+	}
+
+	if request.Status != "" {
+		query = query.Where("status = ?", request.Status)
+	}
+
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	limit := request.Limit
+	offset := (request.Page - 1) * limit
+	err = query.
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&parkedMessages).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	return parkedMessages, total, nil
+}
+
+func (o *IParkedMessage) FindOneByUUID(
+	ctx context.Context,
+	parkedMessageUUID string,
+) (*parkedMessageModel.ParkedMessage, error) {
+	const logCtx = "repositories.parkedmessage.parked_message.FindOneByUUID"
+	var (
+		span          = o.sentry.StartSpan(ctx, logCtx)
+		parkedMessage parkedMessageModel.ParkedMessage
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Where("uuid = ?", parkedMessageUUID).
+		First(&parkedMessage).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorGeneral.ErrParkedMessageNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &parkedMessage, nil
+}
+
+func (o *IParkedMessage) Update(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *parkedMessageDTO.UpdateParkedMessageRequest,
+) error {
+	const logCtx = "repositories.parkedmessage.parked_message.Update"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err := tx.WithContext(ctx).
+		Model(&parkedMessageModel.ParkedMessage{}).
+		Where("id = ?", request.ID).
+		Updates(map[string]interface{}{
+			"status":            request.Status,
+			"replay_count":      request.ReplayCount,
+			"last_replay_error": request.LastReplayError,
+			"replayed_at":       request.ReplayedAt,
+			"updated_at":        &datetime,
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
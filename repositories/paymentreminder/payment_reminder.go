@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	"order-service/constant"
+	paymentReminderModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	paymentReminderDTO "order-service/domain/dto/paymentreminder"
+	errorHelper "order-service/utils/error"
+)
+
+type IPaymentReminder struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IPaymentReminderRepository interface {
+	Create(context.Context, *gorm.DB, *paymentReminderDTO.PaymentReminderRequest) (bool, error)
+	FindAllDue(context.Context, time.Time, int, int) ([]paymentReminderDTO.DuePaymentReminder, error)
+}
+
+func NewPaymentReminder(db *gorm.DB, sentry sentry.ISentry) IPaymentReminderRepository {
+	return &IPaymentReminder{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+// Create records the reminder and reports whether it was recorded, false means another replica
+// has already sent the same reminder.
+func (o *IPaymentReminder) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *paymentReminderDTO.PaymentReminderRequest,
+) (bool, error) {
+	const logCtx = "repositories.paymentreminder.payment_reminder.Create"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&paymentReminderModel.PaymentReminder{
+			SubOrderID:     request.SubOrderID,
+			PaymentID:      request.PaymentID,
+			OffsetInMinute: request.OffsetInMinute,
+			SentAt:         datetime,
+		})
+	if result.Error != nil {
+		return false, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindAllDue returns the unpaid payment links expiring within the offset that were issued before
+// the reminder point and have not received this or a closer reminder yet.
+func (o *IPaymentReminder) FindAllDue(
+	ctx context.Context,
+	now time.Time,
+	offsetInMinute int,
+	limit int,
+) ([]paymentReminderDTO.DuePaymentReminder, error) {
+	const logCtx = "repositories.paymentreminder.payment_reminder.FindAllDue"
+	var (
+		span      = o.sentry.StartSpan(ctx, logCtx)
+		reminders []paymentReminderDTO.DuePaymentReminder
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	remindAt := now.Add(time.Duration(offsetInMinute) * time.Minute)
+	err := o.db.WithContext(ctx).
+		Table("order_payments").
+		Select(`sub_orders.id AS sub_order_id, sub_orders.sub_order_name, sub_orders.payment_type,
+			order_payments.payment_id, order_payments.payment_url, order_payments.amount, sub_orders.currency,
+			order_payments.expired_at, orders.customer_phone, orders.locale, orders.installment_plan_id`).
+		Joins("JOIN sub_orders ON sub_orders.id = order_payments.sub_order_id AND sub_orders.deleted_at IS NULL").
+		Joins("JOIN orders ON orders.id = sub_orders.order_id AND orders.deleted_at IS NULL").
+		Where("sub_orders.status IN ?", []constant.OrderStatus{constant.Pending, constant.PendingPayment}).
+		Where("order_payments.paid_at IS NULL").
+		Where("order_payments.superseded_at IS NULL").
+		Where("order_payments.expired_at > ? AND order_payments.expired_at <= ?", now, remindAt).
+		Where("order_payments.created_at < order_payments.expired_at - ? * INTERVAL '1 minute'", offsetInMinute).
+		Where("NOT EXISTS (?)", o.db.
+			Model(&paymentReminderModel.PaymentReminder{}).
+			Select("1").
+			Where("payment_reminders.payment_id = order_payments.payment_id").
+			Where("payment_reminders.offset_in_minute <= ?", offsetInMinute)).
+		Order("order_payments.expired_at ASC").
+		Limit(limit).
+		Scan(&reminders).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return reminders, nil
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	processedEventModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	processedEventDTO "order-service/domain/dto/processedevent"
+	errorHelper "order-service/utils/error"
+)
+
+type IProcessedEvent struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IProcessedEventRepository interface {
+	Create(context.Context, *gorm.DB, *processedEventDTO.ProcessedEventRequest) (bool, error)
+	DeleteExpired(context.Context) (int64, error)
+}
+
+func NewProcessedEvent(db *gorm.DB, sentry sentry.ISentry) IProcessedEventRepository {
+	return &IProcessedEvent{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+// Create records the event and reports whether it was recorded, false means the same event
+// has already been processed within the dedup window.
+func (o *IProcessedEvent) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *processedEventDTO.ProcessedEventRequest,
+) (bool, error) {
+	const logCtx = "repositories.processedevent.processed_event.Create"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	processedEvent := processedEventModel.ProcessedEvent{
+		EventKey:    request.EventKey,
+		Event:       request.Event,
+		ProcessedAt: datetime,
+		ExpiredAt:   request.ExpiredAt,
+	}
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "event_key"}},
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Lt{Column: clause.Column{Table: processedEvent.TableName(), Name: "expired_at"}, Value: datetime},
+			}},
+			DoUpdates: clause.AssignmentColumns([]string{"event", "processed_at", "expired_at"}),
+		}).
+		Create(&processedEvent)
+	if result.Error != nil {
+		return false, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (o *IProcessedEvent) DeleteExpired(ctx context.Context) (int64, error) {
+	const logCtx = "repositories.processedevent.processed_event.DeleteExpired"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	result := o.db.WithContext(ctx).
+		Where("expired_at < ?", time.Now()).
+		Delete(&processedEventModel.ProcessedEvent{})
+	if result.Error != nil {
+		return 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return result.RowsAffected, nil
+}
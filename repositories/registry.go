@@ -5,11 +5,18 @@ import (
 
 	"order-service/common/sentry"
 
+	installmentPlanRepo "order-service/repositories/installmentplan"
 	orderRepo "order-service/repositories/order"
 	orderHistoryRepo "order-service/repositories/orderhistory"
 	orderInvoiceRepo "order-service/repositories/orderinvoice"
+	orderOutboxRepo "order-service/repositories/orderoutbox"
 	orderPaymentRepo "order-service/repositories/orderpayment"
+	orderRefundRepo "order-service/repositories/orderrefund"
+	parkedMessageRepo "order-service/repositories/parkedmessage"
+	paymentReminderRepo "order-service/repositories/paymentreminder"
+	processedEventRepo "order-service/repositories/processedevent"
 	subOrderRepo "order-service/repositories/suborder"
+	voucherRepo "order-service/repositories/voucher"
 )
 
 type IRepositoryRegistry interface {
@@ -19,6 +26,13 @@ type IRepositoryRegistry interface {
 	GetOrderPayment() orderPaymentRepo.IOrderPaymentRepository
 	GetOrder() orderRepo.IOrderRepository
 	GetOrderInvoice() orderInvoiceRepo.IOrderInvoiceRepository
+	GetOrderOutbox() orderOutboxRepo.IOrderOutboxRepository
+	GetParkedMessage() parkedMessageRepo.IParkedMessageRepository
+	GetProcessedEvent() processedEventRepo.IProcessedEventRepository
+	GetOrderRefund() orderRefundRepo.IOrderRefundRepository
+	GetInstallmentPlan() installmentPlanRepo.IInstallmentPlanRepository
+	GetVoucher() voucherRepo.IVoucherRepository
+	GetPaymentReminder() paymentReminderRepo.IPaymentReminderRepository
 }
 
 type Registry struct {
@@ -53,6 +67,34 @@ func (r *Registry) GetOrderInvoice() orderInvoiceRepo.IOrderInvoiceRepository {
 	return orderInvoiceRepo.NewOrderInvoice(r.db, r.sentry)
 }
 
+func (r *Registry) GetOrderOutbox() orderOutboxRepo.IOrderOutboxRepository {
+	return orderOutboxRepo.NewOrderOutbox(r.db, r.sentry)
+}
+
+func (r *Registry) GetParkedMessage() parkedMessageRepo.IParkedMessageRepository {
+	return parkedMessageRepo.NewParkedMessage(r.db, r.sentry)
+}
+
+func (r *Registry) GetProcessedEvent() processedEventRepo.IProcessedEventRepository {
+	return processedEventRepo.NewProcessedEvent(r.db, r.sentry)
+}
+
+func (r *Registry) GetOrderRefund() orderRefundRepo.IOrderRefundRepository {
+	return orderRefundRepo.NewOrderRefund(r.db, r.sentry)
+}
+
+func (r *Registry) GetInstallmentPlan() installmentPlanRepo.IInstallmentPlanRepository {
+	return installmentPlanRepo.NewInstallmentPlan(r.db, r.sentry)
+}
+
+func (r *Registry) GetVoucher() voucherRepo.IVoucherRepository {
+	return voucherRepo.NewVoucher(r.db, r.sentry)
+}
+
+func (r *Registry) GetPaymentReminder() paymentReminderRepo.IPaymentReminderRepository {
+	return paymentReminderRepo.NewPaymentReminder(r.db, r.sentry)
+}
+
 func (r *Registry) GetTx() *gorm.DB {
 	return r.db
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"order-service/common/sentry"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,7 @@ import (
 	subOrderDTO "order-service/domain/dto/suborder"
 	subOrderModel "order-service/domain/models"
 	errorHelper "order-service/utils/error"
+	"order-service/utils/helper"
 )
 
 type ISubOrder struct {
@@ -30,12 +32,18 @@ type ISubOrderRepository interface {
 	Create(context.Context, *gorm.DB, *subOrderModel.SubOrder) (*subOrderModel.SubOrder, error)
 	FindOneSubOrderByCustomerIDWithLocking(context.Context, uuid.UUID) (*subOrderModel.SubOrder, error)
 	FindOneByUUID(context.Context, string) (*subOrderModel.SubOrder, error)
+	FindOneByUUIDAndCustomerID(context.Context, string, string) (*subOrderModel.SubOrder, error)
+	FindOneByUUIDWithLocking(context.Context, *gorm.DB, string) (*subOrderModel.SubOrder, error)
 	FindOneByOrderIDAndPaymentType(context.Context, uint, string) (*subOrderModel.SubOrder, error)
 	FindAllWithPagination(context.Context, *subOrderDTO.SubOrderRequestParam) ([]subOrderModel.SubOrder, int64, error)
+	FindAllWithCursor(context.Context, *subOrderDTO.SubOrderRequestParam, *helper.Cursor) ([]subOrderModel.SubOrder, error)
+	FindAllByCustomerIDWithPagination(context.Context, string, *subOrderDTO.SubOrderRequestParam) ([]subOrderModel.SubOrder, int64, error)
 	Cancel(context.Context, *gorm.DB, *subOrderDTO.CancelRequest, *subOrderModel.SubOrder) error
 	BulkCreate(context.Context, *gorm.DB, []subOrderModel.SubOrder) ([]subOrderModel.SubOrder, error)
 	Update(context.Context, *gorm.DB, *subOrderDTO.UpdateSubOrderRequest, *subOrderModel.SubOrder) error
 	FindAllByOrderID(context.Context, uint) ([]subOrderModel.SubOrder, error)
+	FindAllExpired(context.Context, time.Time, int) ([]subOrderModel.SubOrder, error)
+	Reopen(context.Context, *gorm.DB, *subOrderModel.SubOrder) error
 }
 
 func NewSubOrder(db *gorm.DB, sentry sentry.ISentry) ISubOrderRepository {
@@ -58,11 +66,20 @@ func (o *ISubOrder) FindAllWithPagination(
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
 
+	query := o.filter(o.db.WithContext(ctx).Model(&subOrderModel.SubOrder{}), request)
+	err := query.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
 	limit := request.Limit
 	offset := (request.Page - 1) * limit
-	err := o.db.WithContext(ctx).
-		Preload("Payment").
+	err = query.
+		Select("sub_orders.*").
+		Preload("Payment", "superseded_at IS NULL").
 		Preload("Order").
+		Order(o.sort(request)).
+		Order("sub_orders.id DESC").
 		Limit(limit).
 		Offset(offset).
 		Find(&order).Error
@@ -73,14 +90,131 @@ func (o *ISubOrder) FindAllWithPagination(
 		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
 	}
 
-	err = o.db.WithContext(ctx).
-		Model(&order).
-		Count(&total).Error
+	return order, total, nil
+}
+
+func (o *ISubOrder) FindAllByCustomerIDWithPagination(
+	ctx context.Context,
+	customerID string,
+	request *subOrderDTO.SubOrderRequestParam,
+) ([]subOrderModel.SubOrder, int64, error) {
+	const logCtx = "repositories.suborder.sub_order.FindAllByCustomerIDWithPagination"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	scoped := *request
+	scoped.CustomerID = customerID
+	return o.FindAllWithPagination(ctx, &scoped)
+}
+
+func (o *ISubOrder) FindAllWithCursor(
+	ctx context.Context,
+	request *subOrderDTO.SubOrderRequestParam,
+	cursor *helper.Cursor,
+) ([]subOrderModel.SubOrder, error) {
+	const logCtx = "repositories.suborder.sub_order.FindAllWithCursor"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order []subOrderModel.SubOrder
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	query := o.filter(o.db.WithContext(ctx).Model(&subOrderModel.SubOrder{}), request)
+	if cursor != nil {
+		query = query.Where("(sub_orders.created_at, sub_orders.id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.
+		Select("sub_orders.*").
+		Preload("Payment", "superseded_at IS NULL").
+		Preload("Order").
+		Order("sub_orders.created_at DESC").
+		Order("sub_orders.id DESC").
+		Limit(request.Limit + 1).
+		Find(&order).Error
 	if err != nil {
-		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
 	}
 
-	return order, total, nil
+	return order, nil
+}
+
+func (o *ISubOrder) filter(query *gorm.DB, request *subOrderDTO.SubOrderRequestParam) *gorm.DB {
+	loc, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	query = query.Joins("LEFT JOIN orders ON orders.id = sub_orders.order_id")
+
+	if request.Status != "" {
+		query = query.Where("sub_orders.status = ?", request.Status.GetStatusInt())
+	}
+
+	if request.PaymentType != "" {
+		query = query.Where("sub_orders.payment_type = ?", request.PaymentType)
+	}
+
+	if request.CustomerID != "" {
+		query = query.Where("orders.customer_id = ?", request.CustomerID)
+	}
+
+	if request.PackageID != "" {
+		query = query.Where("orders.package_id = ?", request.PackageID)
+	}
+
+	if request.IsPaid != nil {
+		query = query.Where("sub_orders.is_paid = ?", *request.IsPaid)
+	}
+
+	if !request.OrderDateFrom.IsZero() {
+		query = query.Where("sub_orders.order_date >= ?", startOfDay(request.OrderDateFrom, loc))
+	}
+
+	if !request.OrderDateTo.IsZero() {
+		query = query.Where("sub_orders.order_date < ?", startOfDay(request.OrderDateTo, loc).AddDate(0, 0, 1))
+	}
+
+	if !request.CreatedFrom.IsZero() {
+		query = query.Where("sub_orders.created_at >= ?", startOfDay(request.CreatedFrom, loc))
+	}
+
+	if !request.CreatedTo.IsZero() {
+		query = query.Where("sub_orders.created_at < ?", startOfDay(request.CreatedTo, loc).AddDate(0, 0, 1))
+	}
+
+	if search := strings.TrimSpace(request.Search); search != "" {
+		keyword := fmt.Sprintf("%%%s%%", escapeLike(search))
+		query = query.Where(
+			o.db.Where("sub_orders.sub_order_name ILIKE ?", keyword).
+				Or("orders.customer_name ILIKE ?", keyword).
+				Or("orders.customer_email ILIKE ?", keyword).
+				Or("orders.customer_phone ILIKE ?", keyword),
+		)
+	}
+
+	return query
+}
+
+func (o *ISubOrder) sort(request *subOrderDTO.SubOrderRequestParam) clause.OrderByColumn {
+	column, ok := constant.SubOrderSortColumns[request.SortBy]
+	if !ok {
+		column = constant.SubOrderSortColumns[constant.SubOrderDefaultSort]
+	}
+
+	return clause.OrderByColumn{
+		Column: clause.Column{Name: column, Raw: true},
+		Desc:   !strings.EqualFold(request.SortDirection, constant.SortAsc),
+	}
+}
+
+func startOfDay(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(value)
 }
 
 func (o *ISubOrder) FindOneByUUID(ctx context.Context, orderUUID string) (*subOrderModel.SubOrder, error) {
@@ -93,9 +227,64 @@ func (o *ISubOrder) FindOneByUUID(ctx context.Context, orderUUID string) (*subOr
 	defer o.sentry.Finish(span)
 
 	err := o.db.WithContext(ctx).
-		Preload("Payment").
+		Preload("Payment", "superseded_at IS NULL").
+		Preload("Order").
+		Where("uuid = ?", orderUUID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrOrderNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &order, nil
+}
+
+func (o *ISubOrder) FindOneByUUIDAndCustomerID(
+	ctx context.Context,
+	orderUUID string,
+	customerID string,
+) (*subOrderModel.SubOrder, error) {
+	const logCtx = "repositories.suborder.sub_order.FindOneByUUIDAndCustomerID"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order subOrderModel.SubOrder
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Preload("Payment", "superseded_at IS NULL").
 		Preload("Order").
+		Joins("JOIN orders ON orders.id = sub_orders.order_id").
+		Where("sub_orders.uuid = ?", orderUUID).
+		Where("orders.customer_id = ?", customerID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrOrderNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &order, nil
+}
+
+func (o *ISubOrder) FindOneByUUIDWithLocking(
+	ctx context.Context,
+	tx *gorm.DB,
+	orderUUID string,
+) (*subOrderModel.SubOrder, error) {
+	const logCtx = "repositories.suborder.sub_order.FindOneByUUIDWithLocking"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order subOrderModel.SubOrder
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
 		Where("uuid = ?", orderUUID).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
 		First(&order).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -127,6 +316,33 @@ func (o *ISubOrder) FindAllByOrderID(ctx context.Context, orderID uint) ([]subOr
 	return order, nil
 }
 
+func (o *ISubOrder) FindAllExpired(
+	ctx context.Context,
+	expiredBefore time.Time,
+	limit int,
+) ([]subOrderModel.SubOrder, error) {
+	const logCtx = "repositories.suborder.sub_order.FindAllExpired"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		order []subOrderModel.SubOrder
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.db.WithContext(ctx).
+		Joins("JOIN order_payments ON order_payments.sub_order_id = sub_orders.id AND order_payments.superseded_at IS NULL").
+		Where("sub_orders.status IN ?", []constant.OrderStatus{constant.Pending, constant.PendingPayment}).
+		Where("order_payments.paid_at IS NULL").
+		Where("order_payments.expired_at < ?", expiredBefore).
+		Order("order_payments.expired_at ASC").
+		Limit(limit).
+		Find(&order).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return order, nil
+}
+
 func (o *ISubOrder) FindOneByOrderIDAndPaymentType(
 	ctx context.Context,
 	orderID uint,
@@ -207,8 +423,10 @@ func (o *ISubOrder) Create(
 		OrderID:      request.OrderID,
 		Status:       request.Status,
 		Amount:       request.Amount,
+		Currency:     request.Currency,
 		PaymentType:  request.PaymentType,
 		OrderDate:    request.OrderDate,
+		DueDate:      request.DueDate,
 		IsPaid:       &isPaid,
 	}
 
@@ -307,16 +525,26 @@ func (o *ISubOrder) Cancel(
 		return errorStatus
 	}
 
-	err := tx.WithContext(ctx).
+	query := tx.WithContext(ctx).
 		Model(&order).
-		Where("uuid = ?", request.UUID).
-		Updates(subOrderModel.SubOrder{
-			Status:     constant.Cancelled,
-			CanceledAt: &canceledAt,
-		}).Error
-	if err != nil {
+		Where("uuid = ?", request.UUID)
+	if request.CustomerID != "" {
+		query = query.Where("order_id IN (?)", tx.Model(&subOrderModel.Order{}).
+			Select("id").
+			Where("customer_id = ?", request.CustomerID))
+	}
+
+	result := query.Updates(subOrderModel.SubOrder{
+		Status:     constant.Cancelled,
+		CanceledAt: &canceledAt,
+	})
+	if result.Error != nil {
 		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
 	}
+
+	if request.CustomerID != "" && result.RowsAffected == 0 {
+		return errOrder.ErrOrderNotFound
+	}
 	return nil
 }
 
@@ -382,3 +610,34 @@ func (o *ISubOrder) Update(
 	}
 	return nil
 }
+
+func (o *ISubOrder) Reopen(ctx context.Context, tx *gorm.DB, current *subOrderModel.SubOrder) error {
+	const logCtx = "repositories.suborder.sub_order.Reopen"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	st := state.NewStatusState(current.Status)
+	if st.FSM.Cannot(constant.Pending.String()) {
+		errorStatus := fmt.Errorf("%w from %s to %s",
+			errorGeneral.ErrInvalidStatusTransition,
+			st.FSM.Current(),
+			constant.Pending.String())
+		return errorStatus
+	}
+
+	err := tx.WithContext(ctx).
+		Model(&subOrderModel.SubOrder{}).
+		Where("uuid = ?", current.UUID).
+		Updates(map[string]interface{}{
+			"status":      constant.Pending,
+			"canceled_at": nil,
+			"updated_at":  time.Now(),
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
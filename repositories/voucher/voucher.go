@@ -0,0 +1,395 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"order-service/common/sentry"
+	voucherModel "order-service/domain/models"
+
+	"time"
+
+	errorGeneral "order-service/constant/error"
+	errOrder "order-service/constant/error/order"
+	voucherDTO "order-service/domain/dto/voucher"
+	errorHelper "order-service/utils/error"
+)
+
+type IVoucher struct {
+	db     *gorm.DB
+	sentry sentry.ISentry
+}
+
+type IVoucherRepository interface {
+	Create(context.Context, *gorm.DB, *voucherDTO.VoucherRequest) (*voucherModel.Voucher, error)
+	Update(
+		context.Context,
+		*gorm.DB,
+		*voucherModel.Voucher,
+		*voucherDTO.VoucherRequest,
+	) (*voucherModel.Voucher, error)
+	Delete(context.Context, *gorm.DB, uint) error
+	FindAllWithPagination(
+		context.Context,
+		*voucherDTO.VoucherRequestParam,
+	) ([]voucherModel.Voucher, int64, error)
+	FindOneByUUID(context.Context, string) (*voucherModel.Voucher, error)
+	FindOneByCode(context.Context, string) (*voucherModel.Voucher, error)
+	FindOneByCodeWithLocking(context.Context, *gorm.DB, string) (*voucherModel.Voucher, error)
+	CountRedemptionByCustomerID(context.Context, *gorm.DB, uint, string) (int64, error)
+	Redeem(context.Context, *gorm.DB, *voucherDTO.RedeemVoucherRequest) error
+	Release(context.Context, *gorm.DB, uint) error
+}
+
+func NewVoucher(db *gorm.DB, sentry sentry.ISentry) IVoucherRepository {
+	return &IVoucher{
+		db:     db,
+		sentry: sentry,
+	}
+}
+
+func (o *IVoucher) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *voucherDTO.VoucherRequest,
+) (*voucherModel.Voucher, error) {
+	const logCtx = "repositories.voucher.voucher.Create"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	voucher := voucherModel.Voucher{
+		UUID:                  uuid.New(),
+		Code:                  strings.ToUpper(request.Code),
+		Name:                  request.Name,
+		Description:           request.Description,
+		DiscountType:          request.DiscountType,
+		Value:                 request.Value,
+		Amount:                request.Amount,
+		MaxDiscountAmount:     request.MaxDiscountAmount,
+		UsageLimit:            request.UsageLimit,
+		UsageLimitPerCustomer: request.UsageLimitPerCustomer,
+		StartDate:             request.StartDate,
+		EndDate:               request.EndDate,
+		IsActive:              request.IsActive,
+		CreatedAt:             &datetime,
+		UpdatedAt:             &datetime,
+		Packages:              o.toVoucherPackages(request, datetime),
+	}
+	err := tx.WithContext(ctx).Create(&voucher).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &voucher, nil
+}
+
+func (o *IVoucher) Update(
+	ctx context.Context,
+	tx *gorm.DB,
+	voucher *voucherModel.Voucher,
+	request *voucherDTO.VoucherRequest,
+) (*voucherModel.Voucher, error) {
+	const logCtx = "repositories.voucher.voucher.Update"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err := tx.WithContext(ctx).
+		Model(voucher).
+		Select(
+			"code", "name", "description", "discount_type", "value", "amount", "max_discount_amount",
+			"usage_limit", "usage_limit_per_customer", "start_date", "end_date", "is_active", "updated_at",
+		).
+		Updates(&voucherModel.Voucher{
+			Code:                  strings.ToUpper(request.Code),
+			Name:                  request.Name,
+			Description:           request.Description,
+			DiscountType:          request.DiscountType,
+			Value:                 request.Value,
+			Amount:                request.Amount,
+			MaxDiscountAmount:     request.MaxDiscountAmount,
+			UsageLimit:            request.UsageLimit,
+			UsageLimitPerCustomer: request.UsageLimitPerCustomer,
+			StartDate:             request.StartDate,
+			EndDate:               request.EndDate,
+			IsActive:              request.IsActive,
+			UpdatedAt:             &datetime,
+		}).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	err = tx.WithContext(ctx).
+		Where("voucher_id = ?", voucher.ID).
+		Delete(&voucherModel.VoucherPackage{}).Error
+	if err != nil {
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	packages := o.toVoucherPackages(request, datetime)
+	for i := range packages {
+		packages[i].VoucherID = voucher.ID
+	}
+	if len(packages) > 0 {
+		err = tx.WithContext(ctx).Create(&packages).Error
+		if err != nil {
+			return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+		}
+	}
+
+	voucher.Packages = packages
+	return voucher, nil
+}
+
+func (o *IVoucher) Delete(ctx context.Context, tx *gorm.DB, id uint) error {
+	const logCtx = "repositories.voucher.voucher.Delete"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&voucherModel.Voucher{}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+func (o *IVoucher) FindAllWithPagination(
+	ctx context.Context,
+	request *voucherDTO.VoucherRequestParam,
+) ([]voucherModel.Voucher, int64, error) {
+	const logCtx = "repositories.voucher.voucher.FindAllWithPagination"
+	var (
+		span     = o.sentry.StartSpan(ctx, logCtx)
+		vouchers []voucherModel.Voucher
+		total    int64
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	query := o.db.WithContext(ctx).Model(&voucherModel.Voucher{})
+	if search := strings.TrimSpace(request.Search); search != "" {
+		replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+		keyword := fmt.Sprintf("%%%s%%", replacer.Replace(search))
+		query = query.Where(o.db.Where("code ILIKE ?", keyword).Or("name ILIKE ?", keyword))
+	}
+
+	err := query.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	limit := request.Limit
+	offset := (request.Page - 1) * limit
+	err = query.
+		Preload("Packages").
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&vouchers).Error
+	if err != nil {
+		return nil, 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	return vouchers, total, nil
+}
+
+func (o *IVoucher) FindOneByUUID(ctx context.Context, voucherUUID string) (*voucherModel.Voucher, error) {
+	const logCtx = "repositories.voucher.voucher.FindOneByUUID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).Where("uuid = ?", voucherUUID))
+}
+
+func (o *IVoucher) FindOneByCode(ctx context.Context, code string) (*voucherModel.Voucher, error) {
+	const logCtx = "repositories.voucher.voucher.FindOneByCode"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(o.db.WithContext(ctx).Unscoped().Where("code = ?", strings.ToUpper(code)))
+}
+
+func (o *IVoucher) FindOneByCodeWithLocking(
+	ctx context.Context,
+	tx *gorm.DB,
+	code string,
+) (*voucherModel.Voucher, error) {
+	const logCtx = "repositories.voucher.voucher.FindOneByCodeWithLocking"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.findOne(tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", strings.ToUpper(code)))
+}
+
+func (o *IVoucher) CountRedemptionByCustomerID(
+	ctx context.Context,
+	tx *gorm.DB,
+	voucherID uint,
+	customerID string,
+) (int64, error) {
+	const logCtx = "repositories.voucher.voucher.CountRedemptionByCustomerID"
+	var (
+		span  = o.sentry.StartSpan(ctx, logCtx)
+		total int64
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Model(&voucherModel.VoucherRedemption{}).
+		Where("voucher_id = ? AND customer_id = ?", voucherID, customerID).
+		Count(&total).Error
+	if err != nil {
+		return 0, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return total, nil
+}
+
+func (o *IVoucher) Redeem(ctx context.Context, tx *gorm.DB, request *voucherDTO.RedeemVoucherRequest) error {
+	const logCtx = "repositories.voucher.voucher.Redeem"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	result := tx.WithContext(ctx).
+		Model(&voucherModel.Voucher{}).
+		Where("id = ? AND (usage_limit IS NULL OR used_count < usage_limit)", request.VoucherID).
+		Updates(map[string]interface{}{
+			"used_count": gorm.Expr("used_count + 1"),
+			"updated_at": datetime,
+		})
+	if result.Error != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	if result.RowsAffected == 0 {
+		return errOrder.ErrVoucherUsageLimitReached
+	}
+
+	err := tx.WithContext(ctx).Create(&voucherModel.VoucherRedemption{
+		VoucherID:      request.VoucherID,
+		CustomerID:     request.CustomerID,
+		OrderID:        request.OrderID,
+		DiscountAmount: request.DiscountAmount,
+		CreatedAt:      &datetime,
+		UpdatedAt:      &datetime,
+	}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+// Release deletes the redemption of the order and gives its usage back to the voucher, an order
+// without a redemption is left as it is.
+func (o *IVoucher) Release(ctx context.Context, tx *gorm.DB, orderID uint) error {
+	const logCtx = "repositories.voucher.voucher.Release"
+	var (
+		span       = o.sentry.StartSpan(ctx, logCtx)
+		redemption voucherModel.VoucherRedemption
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := tx.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&redemption).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	err = tx.WithContext(ctx).Delete(&redemption).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	datetime := time.Now().In(location)
+
+	err = tx.WithContext(ctx).
+		Model(&voucherModel.Voucher{}).
+		Where("id = ? AND used_count > 0", redemption.VoucherID).
+		Updates(map[string]interface{}{
+			"used_count": gorm.Expr("used_count - 1"),
+			"updated_at": datetime,
+		}).Error
+	if err != nil {
+		return errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return nil
+}
+
+func (o *IVoucher) findOne(query *gorm.DB) (*voucherModel.Voucher, error) {
+	var voucher voucherModel.Voucher
+	err := query.
+		Preload("Packages").
+		First(&voucher).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrder.ErrVoucherNotFound
+		}
+		return nil, errorHelper.WrapError(errorGeneral.ErrSQLError, o.sentry)
+	}
+	return &voucher, nil
+}
+
+func (o *IVoucher) toVoucherPackages(
+	request *voucherDTO.VoucherRequest,
+	datetime time.Time,
+) []voucherModel.VoucherPackage {
+	packages := make([]voucherModel.VoucherPackage, 0, len(request.PackageIDs))
+	seen := make(map[uuid.UUID]bool, len(request.PackageIDs))
+	for _, packageID := range request.PackageIDs {
+		if seen[packageID] {
+			continue
+		}
+		seen[packageID] = true
+		packages = append(packages, voucherModel.VoucherPackage{
+			PackageID: packageID.String(),
+			CreatedAt: &datetime,
+			UpdatedAt: &datetime,
+		})
+	}
+	return packages
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	controllerRegistry "order-service/controllers/http"
+)
+
+type ICustomerOrderRoute interface {
+	Run()
+}
+
+type CustomerOrderRoute struct {
+	controller controllerRegistry.IControllerRegistry
+	route      *gin.RouterGroup
+}
+
+func NewCustomerOrderRoute(
+	controller controllerRegistry.IControllerRegistry,
+	route *gin.RouterGroup,
+) ICustomerOrderRoute {
+	return &CustomerOrderRoute{
+		controller: controller,
+		route:      route,
+	}
+}
+
+func (o *CustomerOrderRoute) Run() {
+	group := o.route.Group("/me/orders")
+	group.GET("", o.controller.GetSubOrder().GetMyOrderList)
+	group.GET("/:uuid", o.controller.GetSubOrder().GetMyOrderDetail)
+	group.POST("/:uuid/cancel", o.controller.GetSubOrder().CancelMyOrder)
+}
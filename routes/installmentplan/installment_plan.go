@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"order-service/middlewares"
+
+	controllerRegistry "order-service/controllers/http"
+)
+
+type IInstallmentPlanRoute interface {
+	Run()
+}
+
+type InstallmentPlanRoute struct {
+	controller controllerRegistry.IControllerRegistry
+	route      *gin.RouterGroup
+}
+
+func NewInstallmentPlanRoute(
+	controller controllerRegistry.IControllerRegistry,
+	route *gin.RouterGroup,
+) IInstallmentPlanRoute {
+	return &InstallmentPlanRoute{
+		controller: controller,
+		route:      route,
+	}
+}
+
+func (o *InstallmentPlanRoute) Run() {
+	group := o.route.Group("/admin/installment-plan")
+	group.GET("", middlewares.CheckPermission([]string{
+		"oms:management-order:installment-plan:view",
+	}), o.controller.GetInstallmentPlan().GetInstallmentPlanList)
+	group.POST("", middlewares.CheckPermission([]string{
+		"oms:management-order:installment-plan:create",
+	}), o.controller.GetInstallmentPlan().CreateInstallmentPlan)
+	group.PUT("/:uuid/package", middlewares.CheckPermission([]string{
+		"oms:management-order:installment-plan:update",
+	}), o.controller.GetInstallmentPlan().AssignPackage)
+}
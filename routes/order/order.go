@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"order-service/middlewares"
+
+	controllerRegistry "order-service/controllers/http"
+)
+
+type IOrderRoute interface {
+	Run()
+}
+
+type OrderRoute struct {
+	controller controllerRegistry.IControllerRegistry
+	route      *gin.RouterGroup
+}
+
+func NewOrderRoute(
+	controller controllerRegistry.IControllerRegistry,
+	route *gin.RouterGroup,
+) IOrderRoute {
+	return &OrderRoute{
+		controller: controller,
+		route:      route,
+	}
+}
+
+func (o *OrderRoute) Run() {
+	group := o.route.Group("/orders")
+	group.GET("/:orderUUID", middlewares.CheckPermission([]string{
+		"oms:management-order:order:view",
+	}), o.controller.GetOrder().GetOrderDetail)
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"order-service/middlewares"
+
+	controllerRegistry "order-service/controllers/http"
+)
+
+type IParkedMessageRoute interface {
+	Run()
+}
+
+type ParkedMessageRoute struct {
+	controller controllerRegistry.IControllerRegistry
+	route      *gin.RouterGroup
+}
+
+func NewParkedMessageRoute(
+	controller controllerRegistry.IControllerRegistry,
+	route *gin.RouterGroup,
+) IParkedMessageRoute {
+	return &ParkedMessageRoute{
+		controller: controller,
+		route:      route,
+	}
+}
+
+func (o *ParkedMessageRoute) Run() {
+	group := o.route.Group("/admin/parked-message")
+	group.GET("", middlewares.CheckPermission([]string{
+		"oms:management-order:parked-message:view",
+	}), o.controller.GetParkedMessage().GetParkedMessageList)
+	group.POST("/:uuid/replay", middlewares.CheckPermission([]string{
+		"oms:management-order:parked-message:replay",
+	}), o.controller.GetParkedMessage().ReplayParkedMessage)
+}
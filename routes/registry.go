@@ -5,7 +5,12 @@ import (
 
 	controllerRegistry "order-service/controllers/http"
 	"order-service/middlewares"
+	customerOrderRoute "order-service/routes/customerorder"
+	installmentPlanRoute "order-service/routes/installmentplan"
+	orderRoute "order-service/routes/order"
+	parkedMessageRoute "order-service/routes/parkedmessage"
 	subOrderRoute "order-service/routes/suborder"
+	voucherRoute "order-service/routes/voucher"
 )
 
 type IRouteRegistry interface {
@@ -30,8 +35,33 @@ func NewRouteRegistry(
 func (r *Route) Serve() {
 	r.Route.Use(middlewares.HandlePanic)
 	r.suOrderRoute().Run()
+	r.parkedMessageRoute().Run()
+	r.customerOrderRoute().Run()
+	r.orderRoute().Run()
+	r.installmentPlanRoute().Run()
+	r.voucherRoute().Run()
 }
 
 func (r *Route) suOrderRoute() subOrderRoute.ISubOrderRoute {
 	return subOrderRoute.NewSubOrderRoute(r.controller, r.Route)
 }
+
+func (r *Route) parkedMessageRoute() parkedMessageRoute.IParkedMessageRoute {
+	return parkedMessageRoute.NewParkedMessageRoute(r.controller, r.Route)
+}
+
+func (r *Route) customerOrderRoute() customerOrderRoute.ICustomerOrderRoute {
+	return customerOrderRoute.NewCustomerOrderRoute(r.controller, r.Route)
+}
+
+func (r *Route) orderRoute() orderRoute.IOrderRoute {
+	return orderRoute.NewOrderRoute(r.controller, r.Route)
+}
+
+func (r *Route) installmentPlanRoute() installmentPlanRoute.IInstallmentPlanRoute {
+	return installmentPlanRoute.NewInstallmentPlanRoute(r.controller, r.Route)
+}
+
+func (r *Route) voucherRoute() voucherRoute.IVoucherRoute {
+	return voucherRoute.NewVoucherRoute(r.controller, r.Route)
+}
@@ -38,6 +38,12 @@ func (o *SubOrderRoute) Run() {
 	group.POST("/:uuid", middlewares.CheckPermission([]string{
 		"oms:management-order:order:update",
 	}), o.controller.GetSubOrder().CancelOrder)
+	group.POST("/:uuid/refund", middlewares.CheckPermission([]string{
+		"oms:management-order:order:refund",
+	}), o.controller.GetSubOrder().RefundOrder)
+	group.POST("/:uuid/payment-link", middlewares.CheckPermission([]string{
+		"oms:management-order:order:update",
+	}), o.controller.GetSubOrder().RegeneratePaymentLink)
 	group.POST("", middlewares.CheckPermission([]string{
 		"oms:management-order:order:create",
 	}), o.controller.GetSubOrder().CreateOrder)
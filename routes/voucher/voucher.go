@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"order-service/middlewares"
+
+	controllerRegistry "order-service/controllers/http"
+)
+
+type IVoucherRoute interface {
+	Run()
+}
+
+type VoucherRoute struct {
+	controller controllerRegistry.IControllerRegistry
+	route      *gin.RouterGroup
+}
+
+func NewVoucherRoute(
+	controller controllerRegistry.IControllerRegistry,
+	route *gin.RouterGroup,
+) IVoucherRoute {
+	return &VoucherRoute{
+		controller: controller,
+		route:      route,
+	}
+}
+
+func (o *VoucherRoute) Run() {
+	group := o.route.Group("/admin/voucher")
+	group.GET("", middlewares.CheckPermission([]string{
+		"oms:management-order:voucher:view",
+	}), o.controller.GetVoucher().GetVoucherList)
+	group.GET("/:uuid", middlewares.CheckPermission([]string{
+		"oms:management-order:voucher:view",
+	}), o.controller.GetVoucher().GetVoucherDetail)
+	group.POST("", middlewares.CheckPermission([]string{
+		"oms:management-order:voucher:create",
+	}), o.controller.GetVoucher().CreateVoucher)
+	group.PUT("/:uuid", middlewares.CheckPermission([]string{
+		"oms:management-order:voucher:update",
+	}), o.controller.GetVoucher().UpdateVoucher)
+	group.DELETE("/:uuid", middlewares.CheckPermission([]string{
+		"oms:management-order:voucher:delete",
+	}), o.controller.GetVoucher().DeleteVoucher)
+}
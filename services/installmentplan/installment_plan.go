@@ -0,0 +1,433 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"order-service/common/money"
+	"order-service/common/sentry"
+	"order-service/constant"
+	errOrder "order-service/constant/error/order"
+	installmentPlanDTO "order-service/domain/dto/installmentplan"
+	"order-service/domain/models"
+	"order-service/repositories"
+	"order-service/utils/helper"
+)
+
+type InstallmentPlan struct {
+	repository repositories.IRepositoryRegistry
+	sentry     sentry.ISentry
+}
+
+type IInstallmentPlanService interface {
+	CreateInstallmentPlan(
+		context.Context,
+		*installmentPlanDTO.InstallmentPlanRequest,
+	) (*installmentPlanDTO.InstallmentPlanResponse, error)
+	GetInstallmentPlanList(
+		context.Context,
+		*installmentPlanDTO.InstallmentPlanRequestParam,
+	) (*helper.PaginationResult, error)
+	AssignPackage(
+		context.Context,
+		string,
+		*installmentPlanDTO.AssignPackageRequest,
+	) (*installmentPlanDTO.InstallmentPlanResponse, error)
+	ResolveByPackageID(context.Context, string) (*models.InstallmentPlan, error)
+	ResolveByOrder(context.Context, *models.Order) (*models.InstallmentPlan, error)
+	Validate(
+		context.Context,
+		*models.InstallmentPlan,
+		[]models.SubOrder,
+		money.Money,
+		*installmentPlanDTO.ValidateInstallmentRequest,
+	) (*models.InstallmentPlanItem, *time.Time, error)
+	FindItem(*models.InstallmentPlan, constant.PaymentType) *models.InstallmentPlanItem
+	NextItem(*models.InstallmentPlan, []models.SubOrder) *models.InstallmentPlanItem
+}
+
+func NewInstallmentPlanService(
+	repository repositories.IRepositoryRegistry,
+	sentry sentry.ISentry,
+) IInstallmentPlanService {
+	return &InstallmentPlan{
+		repository: repository,
+		sentry:     sentry,
+	}
+}
+
+// defaultInstallmentPlan mirrors the down payment, 50% and 100% flow that orders followed before
+// installment plans were configurable, it is used when neither the package nor a default plan is set.
+func defaultInstallmentPlan() *models.InstallmentPlan {
+	return &models.InstallmentPlan{
+		Name: constant.DefaultInstallmentPlanName,
+		Items: []models.InstallmentPlanItem{
+			{
+				Sequence:        1,
+				PaymentType:     constant.PTDownPayment,
+				Title:           constant.PTDownPaymentTitle.String(),
+				IndonesianTitle: constant.PTDownPaymentIndonesianTitle.String(),
+				AmountType:      constant.InstallmentPackageDownPayment,
+			},
+			{
+				Sequence:        2,
+				PaymentType:     constant.PTHalfPayment,
+				Title:           constant.PTHalfPaymentTitle.String(),
+				IndonesianTitle: constant.PTHalfPaymentIndonesianTitle.String(),
+				AmountType:      constant.InstallmentRemainingPercentage,
+				Value:           50,
+				IsOptional:      true,
+			},
+			{
+				Sequence:        3,
+				PaymentType:     constant.PTFullPayment,
+				Title:           constant.PTFullPaymentTitle.String(),
+				IndonesianTitle: constant.PTFullPaymentIndonesianTitle.String(),
+				AmountType:      constant.InstallmentRemaining,
+			},
+		},
+	}
+}
+
+func (o *InstallmentPlan) CreateInstallmentPlan(
+	ctx context.Context,
+	request *installmentPlanDTO.InstallmentPlanRequest,
+) (*installmentPlanDTO.InstallmentPlanResponse, error) {
+	const logCtx = "services.installmentplan.installment_plan.CreateInstallmentPlan"
+	var (
+		installmentPlan *models.InstallmentPlan
+		txErr           error
+		span            = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.validatePlan(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		if request.IsDefault {
+			txErr = o.repository.GetInstallmentPlan().ClearDefault(ctx, tx)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		installmentPlan, txErr = o.repository.GetInstallmentPlan().Create(ctx, tx, request)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toInstallmentPlanResponse(installmentPlan), nil
+}
+
+func (o *InstallmentPlan) GetInstallmentPlanList(
+	ctx context.Context,
+	request *installmentPlanDTO.InstallmentPlanRequestParam,
+) (*helper.PaginationResult, error) {
+	const logCtx = "services.installmentplan.installment_plan.GetInstallmentPlanList"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	installmentPlans, total, err := o.repository.GetInstallmentPlan().FindAllWithPagination(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]installmentPlanDTO.InstallmentPlanResponse, 0, len(installmentPlans))
+	for i := range installmentPlans {
+		responses = append(responses, *o.toInstallmentPlanResponse(&installmentPlans[i]))
+	}
+
+	pagination := helper.PaginationParam{
+		Count: total,
+		Page:  request.Page,
+		Limit: request.Limit,
+		Data:  responses,
+	}
+	response := helper.GeneratePagination(pagination)
+	return &response, nil
+}
+
+func (o *InstallmentPlan) AssignPackage(
+	ctx context.Context,
+	planUUID string,
+	request *installmentPlanDTO.AssignPackageRequest,
+) (*installmentPlanDTO.InstallmentPlanResponse, error) {
+	const logCtx = "services.installmentplan.installment_plan.AssignPackage"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	installmentPlan, err := o.repository.GetInstallmentPlan().FindOneByUUID(ctx, planUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.repository.GetInstallmentPlan().
+		AssignPackage(ctx, o.repository.GetTx(), request.PackageID.String(), installmentPlan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toInstallmentPlanResponse(installmentPlan), nil
+}
+
+func (o *InstallmentPlan) ResolveByPackageID(ctx context.Context, packageID string) (*models.InstallmentPlan, error) {
+	const logCtx = "services.installmentplan.installment_plan.ResolveByPackageID"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	installmentPlan, err := o.repository.GetInstallmentPlan().FindOneByPackageID(ctx, packageID)
+	if err == nil {
+		return installmentPlan, nil
+	}
+	if !errors.Is(err, errOrder.ErrInstallmentPlanNotFound) {
+		return nil, err
+	}
+
+	installmentPlan, err = o.repository.GetInstallmentPlan().FindDefault(ctx)
+	if err == nil {
+		return installmentPlan, nil
+	}
+	if !errors.Is(err, errOrder.ErrInstallmentPlanNotFound) {
+		return nil, err
+	}
+
+	return defaultInstallmentPlan(), nil
+}
+
+func (o *InstallmentPlan) ResolveByOrder(ctx context.Context, order *models.Order) (*models.InstallmentPlan, error) {
+	const logCtx = "services.installmentplan.installment_plan.ResolveByOrder"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	if order.InstallmentPlanID == nil {
+		return defaultInstallmentPlan(), nil
+	}
+
+	return o.repository.GetInstallmentPlan().FindOneByID(ctx, *order.InstallmentPlanID)
+}
+
+//nolint:cyclop
+func (o *InstallmentPlan) Validate(
+	ctx context.Context,
+	installmentPlan *models.InstallmentPlan,
+	subOrders []models.SubOrder,
+	remainingOutstandingAmount money.Money,
+	request *installmentPlanDTO.ValidateInstallmentRequest,
+) (*models.InstallmentPlanItem, *time.Time, error) {
+	const logCtx = "services.installmentplan.installment_plan.Validate"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	defer o.sentry.Finish(span)
+
+	item := o.FindItem(installmentPlan, request.PaymentType)
+	if item == nil {
+		return nil, nil, errOrder.ErrInstallmentNotInPlan
+	}
+
+	if request.IsFirstInstallment && item.Sequence != installmentPlan.Items[0].Sequence {
+		return nil, nil, errOrder.ErrInstallmentOutOfOrder
+	}
+
+	paid := make(map[constant.PaymentType]bool, len(subOrders))
+	for _, subOrder := range subOrders {
+		if subOrder.Status == constant.Cancelled {
+			continue
+		}
+
+		isPaid := subOrder.IsPaid != nil && *subOrder.IsPaid
+		if subOrder.PaymentType == item.PaymentType && isPaid {
+			return nil, nil, errOrder.ErrInstallmentAlreadyPaid
+		}
+
+		if !isPaid {
+			return nil, nil, errOrder.ErrPreviousOrderNotEmpty
+		}
+		paid[subOrder.PaymentType] = true
+	}
+
+	for _, planItem := range installmentPlan.Items {
+		switch {
+		case planItem.Sequence < item.Sequence && !planItem.IsOptional && !paid[planItem.PaymentType]:
+			return nil, nil, errOrder.ErrInstallmentOutOfOrder
+		case planItem.Sequence > item.Sequence && paid[planItem.PaymentType]:
+			return nil, nil, errOrder.ErrInstallmentOutOfOrder
+		}
+	}
+
+	amount := o.expectedAmount(item, remainingOutstandingAmount, request)
+	if amount <= 0 || amount > remainingOutstandingAmount || amount != request.Amount {
+		return nil, nil, fmt.Errorf("%w, expected amount is %s", errOrder.ErrInvalidInstallmentAmount, amount)
+	}
+
+	var dueDate *time.Time
+	if item.DueDateOffsetInDay != nil {
+		date := request.OrderDate.AddDate(0, 0, *item.DueDateOffsetInDay)
+		dueDate = &date
+	}
+
+	return item, dueDate, nil
+}
+
+func (o *InstallmentPlan) FindItem(
+	installmentPlan *models.InstallmentPlan,
+	paymentType constant.PaymentType,
+) *models.InstallmentPlanItem {
+	for i := range installmentPlan.Items {
+		if installmentPlan.Items[i].PaymentType == paymentType {
+			return &installmentPlan.Items[i]
+		}
+	}
+	return nil
+}
+
+// NextItem returns the installment the customer is expected to pay next, a pending sub order
+// takes precedence over the plan so an unpaid bill is never skipped.
+func (o *InstallmentPlan) NextItem(
+	installmentPlan *models.InstallmentPlan,
+	subOrders []models.SubOrder,
+) *models.InstallmentPlanItem {
+	paid := make(map[constant.PaymentType]bool, len(subOrders))
+	for _, subOrder := range subOrders {
+		if subOrder.Status == constant.Cancelled {
+			continue
+		}
+
+		if subOrder.IsPaid == nil || !*subOrder.IsPaid {
+			if item := o.FindItem(installmentPlan, subOrder.PaymentType); item != nil {
+				return item
+			}
+			continue
+		}
+		paid[subOrder.PaymentType] = true
+	}
+
+	var next *models.InstallmentPlanItem
+	for i := len(installmentPlan.Items) - 1; i >= 0; i-- {
+		if paid[installmentPlan.Items[i].PaymentType] {
+			break
+		}
+		next = &installmentPlan.Items[i]
+	}
+	return next
+}
+
+// expectedAmount computes the amount due for the installment. Percentages are rounded half away
+// from zero to the decimals of the order currency, the remaining installments take whatever is
+// left of the outstanding amount so the installments always add up to the order total.
+func (o *InstallmentPlan) expectedAmount(
+	item *models.InstallmentPlanItem,
+	remainingOutstandingAmount money.Money,
+	request *installmentPlanDTO.ValidateInstallmentRequest,
+) money.Money {
+	var (
+		amount   money.Money
+		decimals = request.Currency.Decimals()
+	)
+	switch item.AmountType {
+	case constant.InstallmentPercentage:
+		amount = request.PackagePrice.Percent(item.Value, decimals)
+	case constant.InstallmentFixed:
+		amount = item.Amount
+	case constant.InstallmentRemainingPercentage:
+		amount = remainingOutstandingAmount.Percent(item.Value, decimals)
+	case constant.InstallmentRemaining:
+		amount = remainingOutstandingAmount
+	case constant.InstallmentPackageDownPayment:
+		amount = request.PackagePrice.Percent(float64(request.MinimalDownPayment), decimals)
+	}
+	return amount
+}
+
+//nolint:cyclop
+func (o *InstallmentPlan) validatePlan(request *installmentPlanDTO.InstallmentPlanRequest) error {
+	paymentTypes := make(map[constant.PaymentType]bool, len(request.Items))
+	for i, item := range request.Items {
+		isLast := i == len(request.Items)-1
+		if paymentTypes[item.PaymentType] {
+			return fmt.Errorf("%w: payment type %s is duplicated", errOrder.ErrInvalidInstallmentPlan, item.PaymentType)
+		}
+		paymentTypes[item.PaymentType] = true
+
+		switch item.AmountType {
+		case constant.InstallmentPercentage, constant.InstallmentRemainingPercentage:
+			if item.Value <= 0 || item.Value > 100 {
+				return fmt.Errorf("%w: %s must be a percentage", errOrder.ErrInvalidInstallmentPlan, item.PaymentType)
+			}
+		case constant.InstallmentFixed:
+			if item.Amount <= 0 {
+				return fmt.Errorf("%w: %s must have an amount", errOrder.ErrInvalidInstallmentPlan, item.PaymentType)
+			}
+		case constant.InstallmentPackageDownPayment:
+			if i != 0 {
+				return fmt.Errorf("%w: %s can only be the first installment", errOrder.ErrInvalidInstallmentPlan, item.AmountType)
+			}
+		case constant.InstallmentRemaining:
+		}
+
+		if isLast && (item.AmountType != constant.InstallmentRemaining || item.IsOptional) {
+			return fmt.Errorf("%w: the last installment must settle the remaining amount", errOrder.ErrInvalidInstallmentPlan)
+		}
+
+		if !isLast && item.AmountType == constant.InstallmentRemaining {
+			return fmt.Errorf("%w: only the last installment can settle the remaining amount", errOrder.ErrInvalidInstallmentPlan)
+		}
+	}
+
+	if request.Items[0].IsOptional {
+		return fmt.Errorf("%w: the first installment cannot be optional", errOrder.ErrInvalidInstallmentPlan)
+	}
+
+	return nil
+}
+
+func (o *InstallmentPlan) toInstallmentPlanResponse(
+	installmentPlan *models.InstallmentPlan,
+) *installmentPlanDTO.InstallmentPlanResponse {
+	items := make([]installmentPlanDTO.InstallmentPlanItemResponse, 0, len(installmentPlan.Items))
+	for _, item := range installmentPlan.Items {
+		items = append(items, installmentPlanDTO.InstallmentPlanItemResponse{
+			Sequence:           item.Sequence,
+			PaymentType:        item.PaymentType,
+			Title:              item.Title,
+			IndonesianTitle:    item.IndonesianTitle,
+			AmountType:         item.AmountType,
+			Value:              item.Value,
+			Amount:             item.Amount,
+			IsOptional:         item.IsOptional,
+			DueDateOffsetInDay: item.DueDateOffsetInDay,
+		})
+	}
+
+	return &installmentPlanDTO.InstallmentPlanResponse{
+		UUID:        installmentPlan.UUID,
+		Name:        installmentPlan.Name,
+		Description: installmentPlan.Description,
+		IsDefault:   installmentPlan.IsDefault,
+		Items:       items,
+		CreatedAt:   installmentPlan.CreatedAt,
+		UpdatedAt:   installmentPlan.UpdatedAt,
+	}
+}
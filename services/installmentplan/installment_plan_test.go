@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"order-service/common/money"
+	"order-service/constant"
+	errOrder "order-service/constant/error/order"
+	installmentPlanDTO "order-service/domain/dto/installmentplan"
+	"order-service/domain/models"
+	sentryMocks "order-service/mocks/common/sentry"
+)
+
+func newSubOrder(paymentType constant.PaymentType, status constant.OrderStatus, isPaid bool) models.SubOrder {
+	return models.SubOrder{
+		PaymentType: paymentType,
+		Status:      status,
+		IsPaid:      &isPaid,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	sentry := new(sentryMocks.ISentry)
+	sentry.On("StartSpan", mock.Anything, mock.Anything).Return(nil)
+	sentry.On("Finish", mock.Anything).Return()
+	service := &InstallmentPlan{sentry: sentry}
+
+	dueDateOffset := 7
+	planWithDueDate := defaultInstallmentPlan()
+	planWithDueDate.Items[0].DueDateOffsetInDay = &dueDateOffset
+
+	orderDate := time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC)
+	price := money.New(10000000)
+	downPaid := newSubOrder(constant.PTDownPayment, constant.PaymentSuccess, true)
+
+	tests := []struct {
+		name        string
+		plan        *models.InstallmentPlan
+		subOrders   []models.SubOrder
+		remaining   money.Money
+		request     installmentPlanDTO.ValidateInstallmentRequest
+		wantItem    constant.PaymentType
+		wantDueDate *time.Time
+		wantErr     error
+	}{
+		{
+			name:      "first down payment",
+			plan:      defaultInstallmentPlan(),
+			remaining: price,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:        constant.PTDownPayment,
+				Amount:             money.New(3000000),
+				IsFirstInstallment: true,
+			},
+			wantItem: constant.PTDownPayment,
+		},
+		{
+			name:      "first down payment with due date",
+			plan:      planWithDueDate,
+			remaining: price,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:        constant.PTDownPayment,
+				Amount:             money.New(3000000),
+				IsFirstInstallment: true,
+			},
+			wantItem:    constant.PTDownPayment,
+			wantDueDate: func() *time.Time { date := orderDate.AddDate(0, 0, 7); return &date }(),
+		},
+		{
+			name:      "wrong amount",
+			plan:      defaultInstallmentPlan(),
+			remaining: price,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:        constant.PTDownPayment,
+				Amount:             money.New(2500000),
+				IsFirstInstallment: true,
+			},
+			wantErr: errOrder.ErrInvalidInstallmentAmount,
+		},
+		{
+			name:      "payment type outside the plan",
+			plan:      defaultInstallmentPlan(),
+			remaining: price,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PaymentType("monthly"),
+			},
+			wantErr: errOrder.ErrInstallmentNotInPlan,
+		},
+		{
+			name:      "first installment skips the down payment",
+			plan:      defaultInstallmentPlan(),
+			remaining: price,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:        constant.PTHalfPayment,
+				Amount:             money.New(5000000),
+				IsFirstInstallment: true,
+			},
+			wantErr: errOrder.ErrInstallmentOutOfOrder,
+		},
+		{
+			name:      "half payment after the down payment",
+			plan:      defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{downPaid},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTHalfPayment,
+				Amount:      money.New(3500000),
+			},
+			wantItem: constant.PTHalfPayment,
+		},
+		{
+			name:      "optional half payment skipped",
+			plan:      defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{downPaid},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTFullPayment,
+				Amount:      money.New(7000000),
+			},
+			wantItem: constant.PTFullPayment,
+		},
+		{
+			name: "cancelled sub order is ignored",
+			plan: defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{
+				downPaid,
+				newSubOrder(constant.PTHalfPayment, constant.Cancelled, false),
+			},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTHalfPayment,
+				Amount:      money.New(3500000),
+			},
+			wantItem: constant.PTHalfPayment,
+		},
+		{
+			name:      "installment already paid",
+			plan:      defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{downPaid},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTDownPayment,
+				Amount:      money.New(3000000),
+			},
+			wantErr: errOrder.ErrInstallmentAlreadyPaid,
+		},
+		{
+			name: "previous sub order still pending",
+			plan: defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{
+				downPaid,
+				newSubOrder(constant.PTHalfPayment, constant.PendingPayment, false),
+			},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTFullPayment,
+				Amount:      money.New(7000000),
+			},
+			wantErr: errOrder.ErrPreviousOrderNotEmpty,
+		},
+		{
+			name: "earlier installment after a later one",
+			plan: defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{
+				downPaid,
+				newSubOrder(constant.PTFullPayment, constant.PaymentSuccess, true),
+			},
+			remaining: money.New(7000000),
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTHalfPayment,
+				Amount:      money.New(3500000),
+			},
+			wantErr: errOrder.ErrInstallmentOutOfOrder,
+		},
+		{
+			name:      "amount above the remaining outstanding amount",
+			plan:      defaultInstallmentPlan(),
+			subOrders: []models.SubOrder{downPaid},
+			remaining: 0,
+			request: installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType: constant.PTFullPayment,
+				Amount:      0,
+			},
+			wantErr: errOrder.ErrInvalidInstallmentAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := tt.request
+			request.OrderDate = orderDate
+			request.PackagePrice = price
+			request.Currency = constant.CurrencyIDR
+			request.MinimalDownPayment = 30
+
+			item, dueDate, err := service.Validate(context.Background(), tt.plan, tt.subOrders, tt.remaining, &request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if item.PaymentType != tt.wantItem {
+				t.Errorf("Validate() item = %s, want %s", item.PaymentType, tt.wantItem)
+			}
+			if (dueDate == nil) != (tt.wantDueDate == nil) || (dueDate != nil && !dueDate.Equal(*tt.wantDueDate)) {
+				t.Errorf("Validate() due date = %v, want %v", dueDate, tt.wantDueDate)
+			}
+		})
+	}
+}
+
+func TestExpectedAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		item      models.InstallmentPlanItem
+		remaining money.Money
+		price     money.Money
+		currency  constant.Currency
+		want      money.Money
+	}{
+		{
+			name:     "percentage of the price",
+			item:     models.InstallmentPlanItem{AmountType: constant.InstallmentPercentage, Value: 25},
+			price:    money.New(10000000),
+			currency: constant.CurrencyIDR,
+			want:     money.New(2500000),
+		},
+		{
+			name:     "percentage rounded to whole rupiah",
+			item:     models.InstallmentPlanItem{AmountType: constant.InstallmentPercentage, Value: 33.33},
+			price:    money.New(4500001),
+			currency: constant.CurrencyIDR,
+			want:     money.New(1499850),
+		},
+		{
+			name:     "percentage keeps cents",
+			item:     models.InstallmentPlanItem{AmountType: constant.InstallmentPercentage, Value: 33.33},
+			price:    money.New(1001),
+			currency: constant.CurrencyUSD,
+			want:     money.NewFromMinor(33363),
+		},
+		{
+			name:     "fixed",
+			item:     models.InstallmentPlanItem{AmountType: constant.InstallmentFixed, Amount: money.New(1500000)},
+			price:    money.New(10000000),
+			currency: constant.CurrencyIDR,
+			want:     money.New(1500000),
+		},
+		{
+			name:      "percentage of the remaining amount",
+			item:      models.InstallmentPlanItem{AmountType: constant.InstallmentRemainingPercentage, Value: 50},
+			remaining: money.New(7000001),
+			price:     money.New(10000000),
+			currency:  constant.CurrencyIDR,
+			want:      money.New(3500001),
+		},
+		{
+			name:      "remaining amount",
+			item:      models.InstallmentPlanItem{AmountType: constant.InstallmentRemaining},
+			remaining: money.NewFromMinor(700000050),
+			price:     money.New(10000000),
+			currency:  constant.CurrencyIDR,
+			want:      money.NewFromMinor(700000050),
+		},
+		{
+			name:     "package down payment",
+			item:     models.InstallmentPlanItem{AmountType: constant.InstallmentPackageDownPayment},
+			price:    money.New(4500005),
+			currency: constant.CurrencyIDR,
+			want:     money.New(1350002),
+		},
+	}
+
+	service := &InstallmentPlan{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.expectedAmount(&tt.item, tt.remaining, &installmentPlanDTO.ValidateInstallmentRequest{
+				PackagePrice:       tt.price,
+				Currency:           tt.currency,
+				MinimalDownPayment: 30,
+			})
+			if got != tt.want {
+				t.Errorf("expectedAmount() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
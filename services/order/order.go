@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+
+	"order-service/common/money"
+	"order-service/common/sentry"
+	"order-service/constant"
+	orderDTO "order-service/domain/dto/order"
+	orderPaymentDTO "order-service/domain/dto/orderpayment"
+	"order-service/domain/models"
+	"order-service/repositories"
+	installmentPlanService "order-service/services/installmentplan"
+)
+
+type Order struct {
+	repository      repositories.IRepositoryRegistry
+	sentry          sentry.ISentry
+	installmentPlan installmentPlanService.IInstallmentPlanService
+}
+
+type IOrderService interface {
+	GetOrderDetail(context.Context, string) (*orderDTO.OrderResponse, error)
+}
+
+func NewOrderService(
+	repository repositories.IRepositoryRegistry,
+	sentry sentry.ISentry,
+	installmentPlan installmentPlanService.IInstallmentPlanService,
+) IOrderService {
+	return &Order{
+		repository:      repository,
+		sentry:          sentry,
+		installmentPlan: installmentPlan,
+	}
+}
+
+func (o *Order) GetOrderDetail(ctx context.Context, orderUUID string) (*orderDTO.OrderResponse, error) {
+	const logCtx = "services.order.order.GetOrderDetail"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.repository.GetOrder().FindOneAggregateByUUID(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	installmentPlan, err := o.installmentPlan.ResolveByOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	subOrderIDs := make([]uint, 0, len(order.SubOrder))
+	for _, subOrder := range order.SubOrder {
+		subOrderIDs = append(subOrderIDs, subOrder.ID)
+	}
+
+	invoices, err := o.repository.GetOrderInvoice().FindAllBySubOrderIDs(ctx, subOrderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceBySubOrderID := make(map[uint]models.OrderInvoice, len(invoices))
+	for _, invoice := range invoices {
+		invoiceBySubOrderID[invoice.SubOrderID] = invoice
+	}
+
+	refunds, err := o.repository.GetOrderRefund().FindAllSucceededBySubOrderIDs(ctx, subOrderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the refunded amounts were given back to the customer, they no longer count as paid
+	var totalPaid money.Money
+	for _, refund := range refunds {
+		totalPaid -= refund.Amount
+	}
+	subOrders := make([]orderDTO.SubOrderResponse, 0, len(order.SubOrder))
+	for _, subOrder := range order.SubOrder {
+		if subOrder.IsPaid != nil && *subOrder.IsPaid {
+			totalPaid += subOrder.Amount
+		}
+
+		histories := make([]orderDTO.HistoryResponse, 0, len(subOrder.Histories))
+		for _, history := range subOrder.Histories {
+			histories = append(histories, orderDTO.HistoryResponse{
+				Status:    history.Status,
+				CreatedAt: history.CreatedAt,
+			})
+		}
+
+		var invoiceResponse *orderDTO.InvoiceResponse
+		if invoice, ok := invoiceBySubOrderID[subOrder.ID]; ok {
+			invoiceResponse = &orderDTO.InvoiceResponse{
+				InvoiceID:     invoice.InvoiceID,
+				InvoiceNumber: invoice.InvoiceNumber,
+				InvoiceURL:    invoice.InvoiceURL,
+			}
+		}
+
+		subOrders = append(subOrders, orderDTO.SubOrderResponse{
+			SubOrderID:   subOrder.UUID,
+			SubOrderName: subOrder.SubOrderName,
+			PaymentType:  subOrder.PaymentType,
+			Amount:       subOrder.Amount,
+			Currency:     subOrder.Currency,
+			Status:       subOrder.Status.GetStatusString(),
+			IsPaid:       subOrder.IsPaid,
+			OrderDate:    subOrder.OrderDate,
+			DueDate:      subOrder.DueDate,
+			CanceledAt:   subOrder.CanceledAt,
+			CreatedAt:    subOrder.CreatedAt,
+			UpdatedAt:    subOrder.UpdatedAt,
+			Payment:      o.toOrderPaymentResponse(&subOrder.Payment),
+			Invoice:      invoiceResponse,
+			Histories:    histories,
+		})
+	}
+
+	response := &orderDTO.OrderResponse{
+		OrderID:                    order.UUID,
+		OrderName:                  order.OrderName,
+		CustomerID:                 order.CustomerID,
+		CustomerName:               order.CustomerName,
+		CustomerEmail:              order.CustomerEmail,
+		CustomerPhone:              order.CustomerPhone,
+		PackageID:                  order.PackageID,
+		PackagePrice:               order.PackagePrice,
+		Currency:                   order.Currency,
+		Locale:                     order.Locale,
+		PromoName:                  order.PromoName,
+		DiscountAmount:             order.DiscountAmount,
+		VoucherCode:                order.VoucherCode,
+		VoucherDiscountAmount:      order.VoucherDiscountAmount,
+		TotalAmount:                order.TotalAmount,
+		TotalPaid:                  totalPaid,
+		RemainingOutstandingAmount: order.RemainingOutstandingAmount,
+		NextPaymentType:            o.nextPaymentType(order, installmentPlan),
+		IsCompleted:                order.CompletedAt != nil,
+		CompletedAt:                order.CompletedAt,
+		CreatedAt:                  order.CreatedAt,
+		UpdatedAt:                  order.UpdatedAt,
+		SubOrders:                  subOrders,
+	}
+	return response, nil
+}
+
+func (o *Order) nextPaymentType(
+	order *models.Order,
+	installmentPlan *models.InstallmentPlan,
+) *constant.PaymentType {
+	if order.CompletedAt != nil {
+		return nil
+	}
+
+	item := o.installmentPlan.NextItem(installmentPlan, order.SubOrder)
+	if item == nil {
+		return nil
+	}
+	return &item.PaymentType
+}
+
+func (o *Order) toOrderPaymentResponse(payment *models.OrderPayment) *orderPaymentDTO.OrderPaymentResponse {
+	if payment == nil || payment.ID == 0 {
+		return nil
+	}
+
+	var paymentLink string
+	if payment.PaymentURL != nil {
+		paymentLink = *payment.PaymentURL
+	}
+
+	return &orderPaymentDTO.OrderPaymentResponse{
+		PaymentID:   payment.PaymentID,
+		PaymentLink: paymentLink,
+		Status:      payment.Status,
+	}
+}
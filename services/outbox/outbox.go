@@ -0,0 +1,640 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+
+	"order-service/clients"
+	invoiceClient "order-service/clients/invoice"
+	notificationClient "order-service/clients/notification"
+	paymentClient "order-service/clients/payment"
+	"order-service/common/circuitbreaker"
+	"order-service/common/kafka"
+	"order-service/common/locale"
+	"order-service/common/money"
+	"order-service/common/sentry"
+	"order-service/config"
+	"order-service/constant"
+	errorGeneral "order-service/constant/error"
+	orderHistoryDTO "order-service/domain/dto/orderhistory"
+	orderPaymentDTO "order-service/domain/dto/orderpayment"
+	orderRefundDTO "order-service/domain/dto/orderrefund"
+	outboxDTO "order-service/domain/dto/outbox"
+	subOrderDTO "order-service/domain/dto/suborder"
+	"order-service/domain/models"
+	"order-service/repositories"
+	"order-service/utils/helper"
+	"order-service/utils/helper/template"
+)
+
+type Outbox struct {
+	repository repositories.IRepositoryRegistry
+	client     clients.IClientRegistry
+	sentry     sentry.ISentry
+	breaker    circuitbreaker.ICircuitBreaker
+	producer   kafka.IProducer
+}
+
+type IOutboxService interface {
+	Dispatch(context.Context, *models.OrderOutbox) error
+	DispatchPending(context.Context) error
+}
+
+func NewOutboxService(
+	repository repositories.IRepositoryRegistry,
+	client clients.IClientRegistry,
+	sentry sentry.ISentry,
+	breaker circuitbreaker.ICircuitBreaker,
+	producer kafka.IProducer,
+) IOutboxService {
+	return &Outbox{
+		repository: repository,
+		client:     client,
+		sentry:     sentry,
+		breaker:    breaker,
+		producer:   producer,
+	}
+}
+
+func (o *Outbox) DispatchPending(ctx context.Context) error {
+	const logCtx = "services.outbox.outbox.DispatchPending"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	batchSize := config.Config.Outbox.BatchSize
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+
+	outboxes, err := o.repository.GetOrderOutbox().FindAllDue(ctx, batchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range outboxes {
+		err = o.Dispatch(ctx, &outboxes[i])
+		if err != nil {
+			log.Errorf("failed to dispatch outbox %s (%s): %v", outboxes[i].UUID, outboxes[i].Event, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Outbox) Dispatch(ctx context.Context, outbox *models.OrderOutbox) error {
+	const logCtx = "services.outbox.outbox.Dispatch"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	lockTimeout := time.Duration(config.Config.Outbox.LockTimeoutInSecond) * time.Second
+	if lockTimeout <= 0 {
+		lockTimeout = time.Minute
+	}
+
+	lockedUntil := time.Now().Add(lockTimeout)
+	claimed, err := o.repository.GetOrderOutbox().Claim(ctx, outbox.ID, lockedUntil)
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	switch outbox.Event {
+	case constant.OutboxCreatePaymentLink:
+		err = o.createPaymentLink(ctx, outbox)
+	case constant.OutboxExpirePaymentLink:
+		err = o.expirePaymentLink(ctx, outbox)
+	case constant.OutboxGenerateInvoice:
+		err = o.generateInvoice(ctx, outbox)
+	case constant.OutboxSendWhatsapp:
+		err = o.sendWhatsapp(ctx, outbox)
+	case constant.OutboxPublishEvent:
+		err = o.publishEvent(ctx, outbox)
+	case constant.OutboxRefundPayment:
+		err = o.refundPayment(ctx, outbox)
+	case constant.OutboxCreditNote:
+		err = o.generateCreditNote(ctx, outbox)
+	default:
+		err = fmt.Errorf("%w: %s", errorGeneral.ErrOutboxEvent, outbox.Event)
+	}
+	if err != nil {
+		return o.reschedule(ctx, outbox, err)
+	}
+
+	return nil
+}
+
+func (o *Outbox) reschedule(ctx context.Context, outbox *models.OrderOutbox, dispatchErr error) error {
+	attempts := outbox.Attempts + 1
+	lastError := dispatchErr.Error()
+	request := &outboxDTO.UpdateOutboxRequest{
+		ID:            outbox.ID,
+		Status:        constant.OutboxPending,
+		Attempts:      attempts,
+		NextAttemptAt: time.Now().Add(o.backoff(attempts)),
+		LastError:     &lastError,
+	}
+	maxAttempt := config.Config.Outbox.MaxAttempt
+	if maxAttempt <= 0 {
+		maxAttempt = 10
+	}
+	if attempts >= maxAttempt {
+		request.Status = constant.OutboxFailed
+	}
+
+	err := o.repository.GetOrderOutbox().Update(ctx, o.repository.GetTx(), request)
+	if err != nil {
+		return err
+	}
+
+	if request.Status == constant.OutboxFailed {
+		o.markAsFailed(ctx, outbox)
+	}
+
+	return dispatchErr
+}
+
+// markAsFailed releases what an entry reserved once it will not be retried anymore, a pending
+// refund would otherwise keep counting against the refundable amount forever.
+func (o *Outbox) markAsFailed(ctx context.Context, outbox *models.OrderOutbox) {
+	if outbox.Event != constant.OutboxRefundPayment {
+		return
+	}
+
+	var payload outboxDTO.RefundPayload
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		log.Errorf("failed to unmarshal refund outbox %s: %v", outbox.UUID, err)
+		return
+	}
+
+	refund, err := o.repository.GetOrderRefund().FindOneByUUID(ctx, payload.RefundID.String())
+	if err != nil {
+		log.Errorf("failed to find refund %s: %v", payload.RefundID, err)
+		return
+	}
+
+	err = o.repository.GetOrderRefund().Update(ctx, o.repository.GetTx(), &orderRefundDTO.UpdateOrderRefundRequest{
+		ID:     refund.ID,
+		Status: constant.RefundFailed,
+	})
+	if err != nil {
+		log.Errorf("failed to mark refund %s as failed: %v", payload.RefundID, err)
+	}
+}
+
+func (o *Outbox) backoff(attempts int) time.Duration {
+	initial := time.Duration(config.Config.Outbox.BackoffInSecond) * time.Second
+	if initial <= 0 {
+		initial = 5 * time.Second
+	}
+
+	maxBackoff := time.Duration(config.Config.Outbox.MaxBackoffInSecond) * time.Second
+	if maxBackoff <= 0 {
+		maxBackoff = 10 * time.Minute
+	}
+	backoff := time.Duration(float64(initial) * math.Pow(2, float64(attempts-1)))
+	if backoff > maxBackoff || backoff <= 0 {
+		return maxBackoff
+	}
+
+	return backoff
+}
+
+func (o *Outbox) markAsSucceeded(ctx context.Context, tx *gorm.DB, outbox *models.OrderOutbox) error {
+	processedAt := time.Now()
+	return o.repository.GetOrderOutbox().Update(ctx, tx, &outboxDTO.UpdateOutboxRequest{
+		ID:            outbox.ID,
+		Status:        constant.OutboxSucceeded,
+		Attempts:      outbox.Attempts + 1,
+		NextAttemptAt: outbox.NextAttemptAt,
+		ProcessedAt:   &processedAt,
+	})
+}
+
+func (o *Outbox) createPaymentLink(ctx context.Context, outbox *models.OrderOutbox) error {
+	var (
+		payload         outboxDTO.PaymentLinkPayload
+		paymentResponse *paymentClient.PaymentData
+	)
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	orderPayment, err := o.repository.GetOrderPayment().FindBySubOrderID(ctx, outbox.SubOrderID)
+	if err != nil {
+		return err
+	}
+
+	if orderPayment != nil {
+		return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+	}
+
+	paymentRequest := payload.Payment
+	paymentRequest.IdempotencyKey = outbox.IdempotencyKey
+	if paymentRequest.Currency == "" {
+		paymentRequest.Currency = constant.DefaultCurrency
+	}
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		paymentResponse, err = o.client.GetPayment().CreatePaymentLink(ctx, &paymentRequest)
+		return paymentResponse, err
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Payment, request)
+	if err != nil {
+		return err
+	}
+
+	return o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := o.repository.GetOrderPayment().Create(ctx, tx, &orderPaymentDTO.OrderPaymentRequest{
+			Amount:      paymentRequest.Amount,
+			SubOrderID:  outbox.SubOrderID,
+			PaymentID:   paymentResponse.UUID,
+			PaymentLink: paymentResponse.PaymentLink,
+			Status:      paymentResponse.Status,
+			ExpiredAt:   &paymentRequest.ExpiredAt,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		language := locale.Parse(payload.Locale.String())
+		expiredAt := paymentRequest.ExpiredAt
+		_, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+			SubOrderID:     outbox.SubOrderID,
+			Event:          constant.OutboxSendWhatsapp,
+			IdempotencyKey: fmt.Sprintf("%s:%s:%s", constant.OutboxSendWhatsapp, constant.Prepaid, paymentResponse.UUID),
+			Payload: outboxDTO.NotificationPayload{
+				Notification: notificationClient.NotificationRequest{
+					TemplateID:  *template.GetTemplateIDByLocale(constant.Prepaid, language),
+					PhoneNumber: paymentRequest.CustomerDetail.Phone,
+					Data: &notificationClient.SendWhatsappData{
+						OrderID:     payload.SubOrderName,
+						Description: payload.Description,
+						ExpiredAt:   fmt.Sprintf("%s %s", language.Date(expiredAt), expiredAt.Format("15:04")),
+						Amount:      helper.CurrencyFormat(&paymentRequest.Amount, paymentRequest.Currency),
+						Currency:    paymentRequest.Currency.String(),
+					},
+					Button: &notificationClient.Button{
+						URL: &notificationClient.URL{
+							Display: language.Translate(locale.PaymentLinkButton),
+							Link:    paymentResponse.PaymentLink,
+						},
+					},
+				},
+			},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return o.markAsSucceeded(ctx, tx, outbox)
+	})
+}
+
+func (o *Outbox) expirePaymentLink(ctx context.Context, outbox *models.OrderOutbox) error {
+	var payload outboxDTO.ExpirePaymentLinkPayload
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		return nil, o.client.GetPayment().ExpirePayment(ctx, payload.PaymentID)
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Payment, request)
+	if err != nil {
+		return err
+	}
+
+	return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+}
+
+func (o *Outbox) generateInvoice(ctx context.Context, outbox *models.OrderOutbox) error {
+	var (
+		payload         outboxDTO.InvoicePayload
+		invoiceResponse *invoiceClient.InvoiceData
+	)
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	orderInvoice, err := o.repository.GetOrderInvoice().FindBySubOrderID(ctx, outbox.SubOrderID)
+	if err != nil {
+		return err
+	}
+
+	if orderInvoice != nil {
+		return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+	}
+
+	invoiceRequest := payload.Invoice
+	invoiceRequest.IdempotencyKey = outbox.IdempotencyKey
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		invoiceResponse, err = o.client.GetInvoice().GenerateInvoice(ctx, &invoiceRequest)
+		return invoiceResponse, err
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Invoice, request)
+	if err != nil {
+		return err
+	}
+
+	return o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := o.repository.GetOrderInvoice().Create(ctx, tx, &models.OrderInvoice{
+			SubOrderID:    outbox.SubOrderID,
+			InvoiceID:     invoiceResponse.UUID,
+			InvoiceNumber: invoiceRequest.InvoiceNumber,
+			InvoiceURL:    invoiceResponse.URL,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		language := locale.Parse(invoiceRequest.Data.Locale)
+		_, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+			SubOrderID:     outbox.SubOrderID,
+			Event:          constant.OutboxSendWhatsapp,
+			IdempotencyKey: fmt.Sprintf("%s:%s:%s", constant.OutboxSendWhatsapp, constant.Postpaid, invoiceResponse.UUID),
+			Payload: outboxDTO.NotificationPayload{
+				Notification: notificationClient.NotificationRequest{
+					TemplateID:  *template.GetTemplateIDByLocale(constant.Postpaid, language),
+					PhoneNumber: invoiceRequest.Data.Customer.PhoneNumber,
+					Button: &notificationClient.Button{
+						URL: &notificationClient.URL{
+							Display: language.Translate(locale.InvoiceButton),
+							Link:    invoiceResponse.URL,
+						},
+					},
+				},
+			},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return o.markAsSucceeded(ctx, tx, outbox)
+	})
+}
+
+func (o *Outbox) sendWhatsapp(ctx context.Context, outbox *models.OrderOutbox) error {
+	var payload outboxDTO.NotificationPayload
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	notificationRequest := payload.Notification
+	notificationRequest.IdempotencyKey = outbox.IdempotencyKey
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		return nil, o.client.GetNotification().SendToWhatsapp(ctx, &notificationRequest)
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Notification, request)
+	if err != nil {
+		return err
+	}
+
+	return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+}
+
+func (o *Outbox) publishEvent(ctx context.Context, outbox *models.OrderOutbox) error {
+	var payload outboxDTO.EventPayload
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	err = o.producer.Produce(ctx, &kafka.Message{
+		Topic: payload.Topic,
+		Key:   payload.Key,
+		Value: payload.Message,
+	})
+	if err != nil {
+		return err
+	}
+
+	return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+}
+
+//nolint:cyclop,funlen
+func (o *Outbox) refundPayment(ctx context.Context, outbox *models.OrderOutbox) error {
+	var (
+		payload        outboxDTO.RefundPayload
+		refundResponse *paymentClient.RefundData
+	)
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	refund, err := o.repository.GetOrderRefund().FindOneByUUID(ctx, payload.RefundID.String())
+	if err != nil {
+		return err
+	}
+
+	if refund.Status != constant.RefundPending {
+		return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+	}
+
+	refundRequest := payload.Refund
+	refundRequest.PaymentID = payload.PaymentID
+	refundRequest.IdempotencyKey = outbox.IdempotencyKey
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		refundResponse, err = o.client.GetPayment().Refund(ctx, &refundRequest)
+		return refundResponse, err
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Payment, request)
+	if err != nil {
+		return err
+	}
+
+	refundedAt := time.Now()
+	if refundResponse.RefundedAt != nil {
+		refundedAt = *refundResponse.RefundedAt
+	}
+
+	return o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := o.repository.GetOrderRefund().Update(ctx, tx, &orderRefundDTO.UpdateOrderRefundRequest{
+			ID:         refund.ID,
+			RefundID:   &refundResponse.UUID,
+			Status:     constant.RefundSucceeded,
+			RefundedAt: &refundedAt,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		subOrder, txErr := o.repository.GetSubOrder().FindOneByUUIDWithLocking(ctx, tx, refund.SubOrder.UUID.String())
+		if txErr != nil {
+			return txErr
+		}
+
+		payment, txErr := o.repository.GetOrderPayment().FindBySubOrderID(ctx, subOrder.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		refunds, txErr := o.repository.GetOrderRefund().FindAllBySubOrderID(ctx, tx, subOrder.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		var totalRefunded money.Money
+		for _, orderRefund := range refunds {
+			if orderRefund.Status == constant.RefundSucceeded {
+				totalRefunded += orderRefund.Amount
+			}
+		}
+
+		status := constant.PartiallyRefunded
+		if payment != nil && totalRefunded >= payment.Amount {
+			status = constant.Refunded
+		}
+
+		txErr = o.repository.GetSubOrder().Update(ctx, tx, &subOrderDTO.UpdateSubOrderRequest{
+			Status: status,
+		}, &models.SubOrder{
+			UUID:   subOrder.UUID,
+			Status: subOrder.Status,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &orderHistoryDTO.OrderHistoryRequest{
+			SubOrderID: subOrder.ID,
+			Status:     status.GetStatusString(),
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = o.repository.GetOrder().AddRemainingOutstandingAmount(ctx, tx, subOrder.OrderID, refund.Amount)
+		if txErr != nil {
+			return txErr
+		}
+
+		// read the balance back under the lock, the preloaded order may be stale when other refunds
+		// or settlements of the order ran in the meantime
+		order, txErr := o.repository.GetOrder().FindOneOrderByIDWithLocking(ctx, tx, subOrder.OrderID)
+		if txErr != nil {
+			return txErr
+		}
+
+		paymentDetail := invoiceClient.PaymentDetail{
+			RemainingOutstandingAmount: helper.CurrencyFormat(&order.RemainingOutstandingAmount, order.Currency),
+			Date:                       order.Locale.Date(refundedAt),
+			IsPaid:                     true,
+		}
+		if payment != nil {
+			if payment.PaymentType != nil {
+				paymentDetail.PaymentMethod = helper.Ucwords(strings.ReplaceAll(*payment.PaymentType, "_", " "))
+			}
+			if payment.Bank != nil {
+				paymentDetail.BankName = strings.ToUpper(*payment.Bank)
+			}
+			if payment.VANumber != nil {
+				paymentDetail.VaNumber = *payment.VANumber
+			}
+		}
+
+		_, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+			SubOrderID:     subOrder.ID,
+			Event:          constant.OutboxCreditNote,
+			IdempotencyKey: fmt.Sprintf("%s:%s", constant.OutboxCreditNote, refund.UUID),
+			Payload: outboxDTO.CreditNotePayload{
+				RefundID: refund.UUID,
+				Invoice: invoiceClient.InvoiceRequest{
+					InvoiceNumber: fmt.Sprintf("CN/%s/ORD/%d", refundedAt.Format("20060102"), refund.ID),
+					TemplateID:    config.Config.InternalService.Invoice.CreditNoteTemplateID,
+					CreatedBy:     refund.CreatedBy,
+					Data: invoiceClient.Data{
+						Customer: invoiceClient.Customer{
+							Name:        order.CustomerName,
+							Email:       order.CustomerEmail,
+							PhoneNumber: order.CustomerPhone,
+						},
+						PaymentDetail: paymentDetail,
+						Items: []invoiceClient.Item{
+							{
+								Description: fmt.Sprintf("%s %s",
+									order.Locale.Translate(locale.CreditNoteTitle),
+									order.Locale.PaymentTypeTitle(subOrder.PaymentType)),
+								Price: helper.CurrencyFormat(&refund.Amount, order.Currency),
+							},
+						},
+						Total:    helper.CurrencyFormat(&refund.Amount, order.Currency),
+						Currency: order.Currency.String(),
+						Locale:   order.Locale.String(),
+					},
+				},
+			},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return o.markAsSucceeded(ctx, tx, outbox)
+	})
+}
+
+func (o *Outbox) generateCreditNote(ctx context.Context, outbox *models.OrderOutbox) error {
+	var (
+		payload         outboxDTO.CreditNotePayload
+		invoiceResponse *invoiceClient.InvoiceData
+	)
+	err := json.Unmarshal([]byte(outbox.Payload), &payload)
+	if err != nil {
+		return err
+	}
+
+	refund, err := o.repository.GetOrderRefund().FindOneByUUID(ctx, payload.RefundID.String())
+	if err != nil {
+		return err
+	}
+
+	if refund.CreditNoteID != nil {
+		return o.markAsSucceeded(ctx, o.repository.GetTx(), outbox)
+	}
+
+	invoiceRequest := payload.Invoice
+	invoiceRequest.IdempotencyKey = outbox.IdempotencyKey
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		invoiceResponse, err = o.client.GetInvoice().GenerateInvoice(ctx, &invoiceRequest)
+		return invoiceResponse, err
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Invoice, request)
+	if err != nil {
+		return err
+	}
+
+	return o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := o.repository.GetOrderRefund().Update(ctx, tx, &orderRefundDTO.UpdateOrderRefundRequest{
+			ID:               refund.ID,
+			CreditNoteID:     &invoiceResponse.UUID,
+			CreditNoteNumber: &invoiceRequest.InvoiceNumber,
+			CreditNoteURL:    &invoiceResponse.URL,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return o.markAsSucceeded(ctx, tx, outbox)
+	})
+}
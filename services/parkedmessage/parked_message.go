@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"order-service/common/kafka"
+	"order-service/common/sentry"
+	"order-service/config"
+	"order-service/constant"
+	errorGeneral "order-service/constant/error"
+	parkedMessageDTO "order-service/domain/dto/parkedmessage"
+	"order-service/domain/models"
+	"order-service/repositories"
+	"order-service/utils/helper"
+)
+
+type ParkedMessage struct {
+	repository repositories.IRepositoryRegistry
+	sentry     sentry.ISentry
+	producer   kafka.IProducer
+}
+
+type ReplayHandlerResolver func(topic string) func(context.Context, *sarama.ConsumerMessage) error
+
+type IParkedMessageService interface {
+	Park(context.Context, *parkedMessageDTO.ParkedMessageRequest) error
+	GetParkedMessageList(context.Context, *parkedMessageDTO.ParkedMessageRequestParam) (*helper.PaginationResult, error)
+	Replay(context.Context, string, ReplayHandlerResolver) (*parkedMessageDTO.ParkedMessageResponse, error)
+}
+
+func NewParkedMessageService(
+	repository repositories.IRepositoryRegistry,
+	sentry sentry.ISentry,
+	producer kafka.IProducer,
+) IParkedMessageService {
+	return &ParkedMessage{
+		repository: repository,
+		sentry:     sentry,
+		producer:   producer,
+	}
+}
+
+func (o *ParkedMessage) Park(ctx context.Context, request *parkedMessageDTO.ParkedMessageRequest) error {
+	const logCtx = "services.parkedmessage.parked_message.Park"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	_, err := o.repository.GetParkedMessage().Create(ctx, o.repository.GetTx(), request)
+	if err != nil {
+		return err
+	}
+
+	if config.Config.KafkaDeadLetterTopic == "" {
+		return nil
+	}
+
+	headers := make(map[string]string, len(request.Headers)+7)
+	for key, value := range request.Headers {
+		headers[key] = value
+	}
+	headers[constant.DeadLetterOriginalTopic] = request.Topic
+	headers[constant.DeadLetterOriginalPartition] = strconv.FormatInt(int64(request.Partition), 10)
+	headers[constant.DeadLetterOriginalOffset] = strconv.FormatInt(request.Offset, 10)
+	headers[constant.DeadLetterOriginalTimestamp] = request.MessageTimestamp.Format(time.RFC3339Nano)
+	headers[constant.DeadLetterError] = request.Error
+	headers[constant.DeadLetterRetryCount] = strconv.Itoa(request.RetryCount)
+	headers[constant.DeadLetterFailedAt] = request.FailedAt.Format(time.RFC3339Nano)
+
+	var key string
+	if request.Key != nil {
+		key = *request.Key
+	}
+
+	return o.producer.Produce(ctx, &kafka.Message{
+		Topic:   config.Config.KafkaDeadLetterTopic,
+		Key:     key,
+		Value:   []byte(request.Value),
+		Headers: headers,
+	})
+}
+
+func (o *ParkedMessage) GetParkedMessageList(
+	ctx context.Context,
+	request *parkedMessageDTO.ParkedMessageRequestParam,
+) (*helper.PaginationResult, error) {
+	const logCtx = "services.parkedmessage.parked_message.GetParkedMessageList"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	parkedMessages, total, err := o.repository.GetParkedMessage().FindAllWithPagination(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	parkedMessageResponses := make([]parkedMessageDTO.ParkedMessageResponse, 0, len(parkedMessages))
+	for i := range parkedMessages {
+		parkedMessageResponses = append(parkedMessageResponses, o.toParkedMessageResponse(&parkedMessages[i]))
+	}
+
+	pagination := helper.PaginationParam{
+		Count: total,
+		Page:  request.Page,
+		Limit: request.Limit,
+		Data:  parkedMessageResponses,
+	}
+	response := helper.GeneratePagination(pagination)
+	return &response, nil
+}
+
+func (o *ParkedMessage) Replay(
+	ctx context.Context,
+	parkedMessageUUID string,
+	resolver ReplayHandlerResolver,
+) (*parkedMessageDTO.ParkedMessageResponse, error) {
+	const logCtx = "services.parkedmessage.parked_message.Replay"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	parkedMessage, err := o.repository.GetParkedMessage().FindOneByUUID(ctx, parkedMessageUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parkedMessage.Status == constant.ParkedMessageReplayed {
+		return nil, errorGeneral.ErrParkedMessageReplayed
+	}
+
+	handler := resolver(parkedMessage.Topic)
+	if handler == nil {
+		return nil, errorGeneral.ErrReplayHandlerNotFound
+	}
+
+	updateRequest := &parkedMessageDTO.UpdateParkedMessageRequest{
+		ID:          parkedMessage.ID,
+		Status:      parkedMessage.Status,
+		ReplayCount: parkedMessage.ReplayCount + 1,
+	}
+	replayErr := handler(ctx, o.toConsumerMessage(parkedMessage))
+	if replayErr != nil {
+		lastReplayError := replayErr.Error()
+		updateRequest.LastReplayError = &lastReplayError
+	} else {
+		replayedAt := time.Now()
+		updateRequest.Status = constant.ParkedMessageReplayed
+		updateRequest.ReplayedAt = &replayedAt
+	}
+
+	err = o.repository.GetParkedMessage().Update(ctx, o.repository.GetTx(), updateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if replayErr != nil {
+		return nil, replayErr
+	}
+
+	parkedMessage.Status = updateRequest.Status
+	parkedMessage.ReplayCount = updateRequest.ReplayCount
+	parkedMessage.ReplayedAt = updateRequest.ReplayedAt
+	response := o.toParkedMessageResponse(parkedMessage)
+	return &response, nil
+}
+
+func (o *ParkedMessage) toConsumerMessage(parkedMessage *models.ParkedMessage) *sarama.ConsumerMessage {
+	var headers map[string]string
+	_ = json.Unmarshal([]byte(parkedMessage.Headers), &headers) //nolint:errcheck
+
+	recordHeaders := make([]*sarama.RecordHeader, 0, len(headers))
+	for key, value := range headers {
+		recordHeaders = append(recordHeaders, &sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+
+	var key []byte
+	if parkedMessage.Key != nil {
+		key = []byte(*parkedMessage.Key)
+	}
+
+	return &sarama.ConsumerMessage{
+		Headers:   recordHeaders,
+		Timestamp: parkedMessage.MessageTimestamp,
+		Key:       key,
+		Value:     []byte(parkedMessage.Value),
+		Topic:     parkedMessage.Topic,
+		Partition: parkedMessage.Partition,
+		Offset:    parkedMessage.Offset,
+	}
+}
+
+func (o *ParkedMessage) toParkedMessageResponse(
+	parkedMessage *models.ParkedMessage,
+) parkedMessageDTO.ParkedMessageResponse {
+	var headers map[string]string
+	_ = json.Unmarshal([]byte(parkedMessage.Headers), &headers) //nolint:errcheck
+
+	return parkedMessageDTO.ParkedMessageResponse{
+		UUID:             parkedMessage.UUID,
+		Topic:            parkedMessage.Topic,
+		Partition:        parkedMessage.Partition,
+		Offset:           parkedMessage.Offset,
+		Key:              parkedMessage.Key,
+		Value:            parkedMessage.Value,
+		Headers:          headers,
+		Error:            parkedMessage.Error,
+		RetryCount:       parkedMessage.RetryCount,
+		Status:           parkedMessage.Status,
+		ReplayCount:      parkedMessage.ReplayCount,
+		LastReplayError:  parkedMessage.LastReplayError,
+		MessageTimestamp: parkedMessage.MessageTimestamp,
+		FailedAt:         parkedMessage.FailedAt,
+		ReplayedAt:       parkedMessage.ReplayedAt,
+		CreatedAt:        parkedMessage.CreatedAt,
+	}
+}
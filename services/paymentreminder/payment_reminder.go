@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+
+	notificationClient "order-service/clients/notification"
+	"order-service/common/locale"
+	"order-service/common/sentry"
+	"order-service/config"
+	"order-service/constant"
+	outboxDTO "order-service/domain/dto/outbox"
+	paymentReminderDTO "order-service/domain/dto/paymentreminder"
+	"order-service/domain/models"
+	"order-service/repositories"
+	installmentPlanService "order-service/services/installmentplan"
+	outboxService "order-service/services/outbox"
+	"order-service/utils/helper"
+	"order-service/utils/helper/template"
+)
+
+type PaymentReminder struct {
+	repository      repositories.IRepositoryRegistry
+	sentry          sentry.ISentry
+	outbox          outboxService.IOutboxService
+	installmentPlan installmentPlanService.IInstallmentPlanService
+}
+
+type IPaymentReminderService interface {
+	SendDueReminders(context.Context) (int, error)
+}
+
+func NewPaymentReminderService(
+	repository repositories.IRepositoryRegistry,
+	sentry sentry.ISentry,
+	outbox outboxService.IOutboxService,
+	installmentPlan installmentPlanService.IInstallmentPlanService,
+) IPaymentReminderService {
+	return &PaymentReminder{
+		repository:      repository,
+		sentry:          sentry,
+		outbox:          outbox,
+		installmentPlan: installmentPlan,
+	}
+}
+
+// SendDueReminders queues a whatsapp reminder for every unpaid payment link that is about to expire.
+// The offsets are walked from the closest to the expiry, so a link that is already past several
+// reminder points only receives the most urgent one.
+func (o *PaymentReminder) SendDueReminders(ctx context.Context) (int, error) {
+	const logCtx = "services.paymentreminder.payment_reminder.SendDueReminders"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	templateID := template.GetTemplateIDByName(constant.PaymentReminder)
+	if templateID == nil {
+		log.Warnf("skipping payment reminders, template %s is not configured", constant.PaymentReminder)
+		return 0, nil
+	}
+
+	batchSize := config.Config.PaymentReminder.BatchSize
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+
+	offsets := append([]int{}, config.Config.PaymentReminder.OffsetsInMinute...)
+	sort.Ints(offsets)
+
+	var sent int
+	now := time.Now()
+	for _, offset := range offsets {
+		if offset <= 0 {
+			continue
+		}
+
+		reminders, err := o.repository.GetPaymentReminder().FindAllDue(ctx, now, offset, batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for i := range reminders {
+			recorded, err := o.send(ctx, *templateID, offset, &reminders[i])
+			if err != nil {
+				log.Errorf("error sending payment reminder for payment %s: %v", reminders[i].PaymentID, err)
+				continue
+			}
+
+			if recorded {
+				sent++
+			}
+		}
+	}
+	return sent, nil
+}
+
+func (o *PaymentReminder) send(
+	ctx context.Context,
+	templateID string,
+	offset int,
+	reminder *paymentReminderDTO.DuePaymentReminder,
+) (bool, error) {
+	const logCtx = "services.paymentreminder.payment_reminder.send"
+	var (
+		outbox *models.OrderOutbox
+		span   = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	description := reminder.Locale.PaymentTypeTitle(reminder.PaymentType)
+	installmentPlan, err := o.installmentPlan.ResolveByOrder(ctx, &models.Order{
+		InstallmentPlanID: reminder.InstallmentPlanID,
+	})
+	if err != nil {
+		return false, err
+	}
+	if item := o.installmentPlan.FindItem(installmentPlan, reminder.PaymentType); item != nil {
+		description = reminder.Locale.Title(item.Title, item.IndonesianTitle)
+	}
+	if localized := template.GetTemplateIDByLocale(constant.PaymentReminder, reminder.Locale); localized != nil {
+		templateID = *localized
+	}
+
+	var paymentLink string
+	if reminder.PaymentURL != nil {
+		paymentLink = *reminder.PaymentURL
+	}
+
+	expiredAt := reminder.ExpiredAt
+	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		recorded, txErr := o.repository.GetPaymentReminder().Create(ctx, tx, &paymentReminderDTO.PaymentReminderRequest{
+			SubOrderID:     reminder.SubOrderID,
+			PaymentID:      reminder.PaymentID,
+			OffsetInMinute: offset,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		if !recorded {
+			return nil
+		}
+
+		outbox, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+			SubOrderID: reminder.SubOrderID,
+			Event:      constant.OutboxSendWhatsapp,
+			IdempotencyKey: fmt.Sprintf("%s:%s:%s:%d",
+				constant.OutboxSendWhatsapp, constant.PaymentReminder, reminder.PaymentID, offset),
+			Payload: outboxDTO.NotificationPayload{
+				Notification: notificationClient.NotificationRequest{
+					TemplateID:  templateID,
+					PhoneNumber: reminder.CustomerPhone,
+					Data: &notificationClient.SendWhatsappData{
+						OrderID:     reminder.SubOrderName,
+						Description: description,
+						ExpiredAt:   fmt.Sprintf("%s %s", reminder.Locale.Date(expiredAt), expiredAt.Format("15:04")),
+						Amount:      helper.CurrencyFormat(&reminder.Amount, reminder.Currency),
+						Currency:    reminder.Currency.String(),
+					},
+					Button: &notificationClient.Button{
+						URL: &notificationClient.URL{
+							Display: reminder.Locale.Translate(locale.PaymentLinkButton),
+							Link:    paymentLink,
+						},
+					},
+				},
+			},
+		})
+		return txErr
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if outbox == nil {
+		return false, nil
+	}
+
+	err = o.outbox.Dispatch(ctx, outbox)
+	if err != nil {
+		log.Errorf("failed to dispatch outbox %s, it will be retried: %v", outbox.UUID, err)
+	}
+	return true, nil
+}
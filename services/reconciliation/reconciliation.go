@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"order-service/clients"
+	paymentClient "order-service/clients/payment"
+	"order-service/common/circuitbreaker"
+	"order-service/common/sentry"
+	"order-service/constant"
+	orderPaymentDTO "order-service/domain/dto/orderpayment"
+	reconciliationDTO "order-service/domain/dto/reconciliation"
+	subOrderDTO "order-service/domain/dto/suborder"
+	"order-service/repositories"
+	subOrderService "order-service/services/suborder"
+)
+
+type Reconciliation struct {
+	repository repositories.IRepositoryRegistry
+	client     clients.IClientRegistry
+	sentry     sentry.ISentry
+	breaker    circuitbreaker.ICircuitBreaker
+	subOrder   subOrderService.ISubOrderService
+}
+
+type IReconciliationService interface {
+	Reconcile(
+		context.Context,
+		*reconciliationDTO.ReconciliationRequest,
+	) (*reconciliationDTO.ReconciliationReport, error)
+}
+
+func NewReconciliationService(
+	repository repositories.IRepositoryRegistry,
+	client clients.IClientRegistry,
+	sentry sentry.ISentry,
+	breaker circuitbreaker.ICircuitBreaker,
+	subOrder subOrderService.ISubOrderService,
+) IReconciliationService {
+	return &Reconciliation{
+		repository: repository,
+		client:     client,
+		sentry:     sentry,
+		breaker:    breaker,
+		subOrder:   subOrder,
+	}
+}
+
+// Reconcile compares every unsettled payment with the payment service and replays the callbacks
+// that never arrived through the same flow as the kafka consumer. Only the payments whose status
+// differs are listed in the report.
+func (o *Reconciliation) Reconcile(
+	ctx context.Context,
+	request *reconciliationDTO.ReconciliationRequest,
+) (*reconciliationDTO.ReconciliationReport, error) {
+	const logCtx = "services.reconciliation.reconciliation.Reconcile"
+	var (
+		span   = o.sentry.StartSpan(ctx, logCtx)
+		report = &reconciliationDTO.ReconciliationReport{
+			StartedAt: time.Now(),
+			DryRun:    request.DryRun,
+			Items:     make([]reconciliationDTO.ReconciliationItem, 0),
+		}
+		lastID uint
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	batchSize := request.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	for {
+		payments, err := o.repository.GetOrderPayment().FindAllUnsettled(ctx, lastID, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range payments {
+			report.Checked++
+			item := o.reconcile(ctx, &payments[i], request.DryRun)
+			if item == nil {
+				continue
+			}
+
+			report.Mismatched++
+			switch item.Action {
+			case constant.ReconciliationApplied:
+				report.Applied++
+			case constant.ReconciliationFailed:
+				report.Failed++
+			}
+			report.Items = append(report.Items, *item)
+		}
+
+		if len(payments) < batchSize {
+			break
+		}
+		lastID = payments[len(payments)-1].ID
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+func (o *Reconciliation) reconcile(
+	ctx context.Context,
+	payment *orderPaymentDTO.UnsettledPayment,
+	dryRun bool,
+) *reconciliationDTO.ReconciliationItem {
+	var localStatus string
+	if payment.Status != nil {
+		localStatus = *payment.Status
+	}
+
+	item := &reconciliationDTO.ReconciliationItem{
+		PaymentID:      payment.PaymentID,
+		SubOrderID:     payment.SubOrderUUID,
+		SubOrderStatus: payment.SubOrderStatus,
+		Amount:         payment.Amount,
+		LocalStatus:    localStatus,
+	}
+
+	var remote *paymentClient.PaymentData
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		var err error
+		remote, err = o.client.GetPayment().GetPaymentStatus(ctx, payment.PaymentID)
+		return remote, err
+	})
+	err := o.breaker.Execute(ctx, circuitbreaker.Payment, request)
+	if err != nil {
+		item.Action = constant.ReconciliationFailed
+		item.Error = err.Error()
+		return item
+	}
+
+	if remote.Status != nil {
+		item.RemoteStatus = strings.ToLower(*remote.Status)
+	}
+
+	if item.RemoteStatus == "" || item.RemoteStatus == localStatus {
+		return nil
+	}
+
+	if dryRun {
+		item.Action = constant.ReconciliationDryRun
+		return item
+	}
+
+	item.Action, err = o.apply(ctx, payment, remote, item.RemoteStatus)
+	if err != nil {
+		log.Errorf("error reconciling payment %s: %v", payment.PaymentID, err)
+		item.Error = err.Error()
+	}
+	return item
+}
+
+func (o *Reconciliation) apply(
+	ctx context.Context,
+	payment *orderPaymentDTO.UnsettledPayment,
+	remote *paymentClient.PaymentData,
+	remoteStatus string,
+) (constant.ReconciliationAction, error) {
+	var paymentType string
+	if remote.PaymentType != nil && *remote.PaymentType == constant.BankTransferPaymentMethod {
+		paymentType = constant.VirtualAccountBankTransfer
+	}
+
+	paidAt := remote.PaidAt
+	if paidAt == nil {
+		paidAt = remote.UpdatedAt
+	}
+
+	request := func(event string) *subOrderDTO.PaymentRequest {
+		return &subOrderDTO.PaymentRequest{
+			Event:       event,
+			OrderID:     payment.SubOrderUUID,
+			PaymentID:   payment.PaymentID,
+			PaymentLink: remote.PaymentLink,
+			PaymentType: paymentType,
+			Amount:      remote.Amount,
+			Status:      remoteStatus,
+			VaNumber:    remote.VANumber,
+			Bank:        remote.Bank,
+			Acquirer:    remote.Acquirer,
+			PaidAt:      paidAt,
+		}
+	}
+
+	var err error
+	switch {
+	case remoteStatus == constant.PaymentStatusPending.String() && payment.SubOrderStatus == constant.Pending:
+		err = o.subOrder.ReceivePendingPayment(ctx, request(constant.PaymentEventPending))
+	case remoteStatus == constant.PaymentStatusSettlement.String() && payment.SubOrderStatus == constant.Pending:
+		err = o.subOrder.ReceivePendingPayment(ctx, request(constant.PaymentEventPending))
+		if err == nil {
+			err = o.subOrder.ReceivePaymentSettlement(ctx, request(constant.PaymentEventSettlement))
+		}
+	case remoteStatus == constant.PaymentStatusSettlement.String() && payment.SubOrderStatus == constant.PendingPayment:
+		err = o.subOrder.ReceivePaymentSettlement(ctx, request(constant.PaymentEventSettlement))
+	case remoteStatus == constant.PaymentStatusExpire.String() &&
+		(payment.SubOrderStatus == constant.Pending || payment.SubOrderStatus == constant.PendingPayment):
+		err = o.subOrder.ReceivePaymentExpire(ctx, request(constant.PaymentEventExpire))
+	default:
+		return constant.ReconciliationUnresolved, nil
+	}
+	if err != nil {
+		return constant.ReconciliationFailed, err
+	}
+	return constant.ReconciliationApplied, nil
+}
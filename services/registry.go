@@ -3,13 +3,28 @@ package services
 import (
 	"order-service/clients"
 	"order-service/common/circuitbreaker"
+	"order-service/common/kafka"
 	"order-service/common/sentry"
 	repositoryRegistry "order-service/repositories"
-	orderService "order-service/services/suborder"
+	installmentPlanService "order-service/services/installmentplan"
+	orderService "order-service/services/order"
+	outboxService "order-service/services/outbox"
+	parkedMessageService "order-service/services/parkedmessage"
+	paymentReminderService "order-service/services/paymentreminder"
+	reconciliationService "order-service/services/reconciliation"
+	subOrderService "order-service/services/suborder"
+	voucherService "order-service/services/voucher"
 )
 
 type IServiceRegistry interface {
-	GetSubOrder() orderService.ISubOrderService
+	GetSubOrder() subOrderService.ISubOrderService
+	GetOutbox() outboxService.IOutboxService
+	GetParkedMessage() parkedMessageService.IParkedMessageService
+	GetOrder() orderService.IOrderService
+	GetInstallmentPlan() installmentPlanService.IInstallmentPlanService
+	GetVoucher() voucherService.IVoucherService
+	GetPaymentReminder() paymentReminderService.IPaymentReminderService
+	GetReconciliation() reconciliationService.IReconciliationService
 }
 
 type Registry struct {
@@ -17,6 +32,7 @@ type Registry struct {
 	client     clients.IClientRegistry
 	sentry     sentry.ISentry
 	breaker    circuitbreaker.ICircuitBreaker
+	producer   kafka.IProducer
 }
 
 func NewServiceRegistry(
@@ -24,15 +40,53 @@ func NewServiceRegistry(
 	client clients.IClientRegistry,
 	sentry sentry.ISentry,
 	breaker circuitbreaker.ICircuitBreaker,
+	producer kafka.IProducer,
 ) IServiceRegistry {
 	return &Registry{
 		repository: repository,
 		client:     client,
 		sentry:     sentry,
 		breaker:    breaker,
+		producer:   producer,
 	}
 }
 
-func (s *Registry) GetSubOrder() orderService.ISubOrderService {
-	return orderService.NewSubOrderService(s.repository, s.client, s.sentry, s.breaker)
+func (s *Registry) GetSubOrder() subOrderService.ISubOrderService {
+	return subOrderService.NewSubOrderService(
+		s.repository,
+		s.client,
+		s.sentry,
+		s.breaker,
+		s.GetOutbox(),
+		s.GetInstallmentPlan(),
+		s.GetVoucher(),
+	)
+}
+
+func (s *Registry) GetOutbox() outboxService.IOutboxService {
+	return outboxService.NewOutboxService(s.repository, s.client, s.sentry, s.breaker, s.producer)
+}
+
+func (s *Registry) GetParkedMessage() parkedMessageService.IParkedMessageService {
+	return parkedMessageService.NewParkedMessageService(s.repository, s.sentry, s.producer)
+}
+
+func (s *Registry) GetOrder() orderService.IOrderService {
+	return orderService.NewOrderService(s.repository, s.sentry, s.GetInstallmentPlan())
+}
+
+func (s *Registry) GetInstallmentPlan() installmentPlanService.IInstallmentPlanService {
+	return installmentPlanService.NewInstallmentPlanService(s.repository, s.sentry)
+}
+
+func (s *Registry) GetVoucher() voucherService.IVoucherService {
+	return voucherService.NewVoucherService(s.repository, s.sentry)
+}
+
+func (s *Registry) GetPaymentReminder() paymentReminderService.IPaymentReminderService {
+	return paymentReminderService.NewPaymentReminderService(s.repository, s.sentry, s.GetOutbox(), s.GetInstallmentPlan())
+}
+
+func (s *Registry) GetReconciliation() reconciliationService.IReconciliationService {
+	return reconciliationService.NewReconciliationService(s.repository, s.client, s.sentry, s.breaker, s.GetSubOrder())
 }
@@ -2,16 +2,16 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
-	"sync"
+	"strconv"
 
 	invoiceModel "order-service/clients/invoice"
-	notificationClient "order-service/clients/notification"
 	packageClient "order-service/clients/weddingpackage"
 	"order-service/config"
 	"order-service/utils/helper/rbac"
-	"order-service/utils/helper/template"
 
 	"strings"
 	"time"
@@ -19,49 +19,63 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 
 	"order-service/clients"
 	paymentClient "order-service/clients/payment"
 	"order-service/common/circuitbreaker"
 	"order-service/common/sentry"
 	errorGeneral "order-service/constant/error"
+	kafkaDTO "order-service/domain/dto/kafka"
+	orderEventDTO "order-service/domain/dto/kafka/order"
 	orderDTO "order-service/domain/dto/order"
 
+	"order-service/common/locale"
+	"order-service/common/metrics"
+	"order-service/common/money"
 	"order-service/constant"
 	errOrder "order-service/constant/error/order"
+	installmentPlanDTO "order-service/domain/dto/installmentplan"
 	orderHistoryDTO "order-service/domain/dto/orderhistory"
 	orderPaymentDTO "order-service/domain/dto/orderpayment"
+	orderRefundDTO "order-service/domain/dto/orderrefund"
+	outboxDTO "order-service/domain/dto/outbox"
+	processedEventDTO "order-service/domain/dto/processedevent"
 	subOrderDTO "order-service/domain/dto/suborder"
+	voucherDTO "order-service/domain/dto/voucher"
 	"order-service/domain/models"
 	"order-service/repositories"
+	installmentPlanService "order-service/services/installmentplan"
+	outboxService "order-service/services/outbox"
+	voucherService "order-service/services/voucher"
 	"order-service/utils/helper"
 )
 
 type SubOrder struct {
-	repository repositories.IRepositoryRegistry
-	client     clients.IClientRegistry
-	sentry     sentry.ISentry
-	breaker    circuitbreaker.ICircuitBreaker
-}
-
-type ClientResponse struct {
-	packageData       *packageClient.PackageData
-	paymentData       *paymentClient.PaymentData
-	invoiceData       *invoiceModel.InvoiceData
-	packageError      error
-	invoiceError      error
-	paymentError      error
-	notificationError error
+	repository      repositories.IRepositoryRegistry
+	client          clients.IClientRegistry
+	sentry          sentry.ISentry
+	breaker         circuitbreaker.ICircuitBreaker
+	outbox          outboxService.IOutboxService
+	installmentPlan installmentPlanService.IInstallmentPlanService
+	voucher         voucherService.IVoucherService
 }
 
 type ISubOrderService interface {
 	CreateOrder(context.Context, *subOrderDTO.SubOrderRequest) (*subOrderDTO.SubOrderResponse, error)
 	Cancel(context.Context, string) error
 	GetSubOrderList(context.Context, *subOrderDTO.SubOrderRequestParam) (*helper.PaginationResult, error)
+	GetSubOrderListByCursor(context.Context, *subOrderDTO.SubOrderRequestParam) (*helper.CursorPaginationResult, error)
 	GetOrderDetail(context.Context, string) (*subOrderDTO.SubOrderResponse, error)
+	GetMyOrderList(context.Context, *subOrderDTO.SubOrderRequestParam) (*helper.PaginationResult, error)
+	GetMyOrderDetail(context.Context, string) (*subOrderDTO.SubOrderResponse, error)
+	CancelMyOrder(context.Context, string) error
 	ReceivePendingPayment(context.Context, *subOrderDTO.PaymentRequest) error
 	ReceivePaymentSettlement(context.Context, *subOrderDTO.PaymentRequest) error
 	ReceivePaymentExpire(context.Context, *subOrderDTO.PaymentRequest) error
+	Refund(context.Context, string, *orderRefundDTO.RefundRequest) (*orderRefundDTO.RefundResponse, error)
+	ExpireOverdue(context.Context) (int, error)
+	RegeneratePaymentLink(context.Context, string) (*subOrderDTO.SubOrderResponse, error)
 }
 
 func NewSubOrderService(
@@ -69,12 +83,18 @@ func NewSubOrderService(
 	client clients.IClientRegistry,
 	sentry sentry.ISentry,
 	breaker circuitbreaker.ICircuitBreaker,
+	outbox outboxService.IOutboxService,
+	installmentPlan installmentPlanService.IInstallmentPlanService,
+	voucher voucherService.IVoucherService,
 ) ISubOrderService {
 	return &SubOrder{
-		repository: repository,
-		client:     client,
-		sentry:     sentry,
-		breaker:    breaker,
+		repository:      repository,
+		client:          client,
+		sentry:          sentry,
+		breaker:         breaker,
+		outbox:          outbox,
+		installmentPlan: installmentPlan,
+		voucher:         voucher,
 	}
 }
 
@@ -84,9 +104,8 @@ func (o *SubOrder) GetSubOrderList(
 ) (*helper.PaginationResult, error) {
 	const logCtx = "services.suborder.sub_order.GetSubOrderList"
 	var (
-		orders []models.SubOrder
-		total  int64
-		span   = o.sentry.StartSpan(ctx, logCtx)
+		total int64
+		span  = o.sentry.StartSpan(ctx, logCtx)
 	)
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
@@ -96,7 +115,62 @@ func (o *SubOrder) GetSubOrderList(
 		return nil, err
 	}
 
-	orderResponses := make([]subOrderDTO.SubOrderResponse, 0, len(orders))
+	pagination := helper.PaginationParam{
+		Count: total,
+		Page:  request.Page,
+		Limit: request.Limit,
+		Data:  o.toSubOrderListResponse(subOrders),
+	}
+	response := helper.GeneratePagination(pagination)
+	return &response, nil
+}
+
+func (o *SubOrder) GetSubOrderListByCursor(
+	ctx context.Context,
+	request *subOrderDTO.SubOrderRequestParam,
+) (*helper.CursorPaginationResult, error) {
+	const logCtx = "services.suborder.sub_order.GetSubOrderListByCursor"
+	var (
+		cursor *helper.Cursor
+		span   = o.sentry.StartSpan(ctx, logCtx)
+		err    error
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	if request.Cursor != "" {
+		cursor, err = helper.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, errorGeneral.ErrInvalidCursor
+		}
+	}
+
+	subOrders, err := o.repository.GetSubOrder().FindAllWithCursor(ctx, request, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor *helper.Cursor
+	if len(subOrders) > request.Limit {
+		subOrders = subOrders[:request.Limit]
+		last := subOrders[len(subOrders)-1]
+		nextCursor = &helper.Cursor{
+			CreatedAt: *last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	pagination := helper.CursorPaginationParam{
+		Limit:      request.Limit,
+		NextCursor: nextCursor,
+		Data:       o.toSubOrderListResponse(subOrders),
+	}
+	response := helper.GenerateCursorPagination(pagination)
+	return &response, nil
+}
+
+func (o *SubOrder) toSubOrderListResponse(subOrders []models.SubOrder) []subOrderDTO.SubOrderResponse {
+	orderResponses := make([]subOrderDTO.SubOrderResponse, 0, len(subOrders))
 	for _, subOrder := range subOrders {
 		orderResponses = append(orderResponses, subOrderDTO.SubOrderResponse{
 			OrderID:      subOrder.Order.UUID,
@@ -105,28 +179,62 @@ func (o *SubOrder) GetSubOrderList(
 			CustomerID:   subOrder.Order.CustomerID,
 			PackageID:    subOrder.Order.PackageID,
 			Amount:       subOrder.Amount,
+			Currency:     subOrder.Currency,
 			Status:       subOrder.Status,
 			IsPaid:       subOrder.IsPaid,
 			CreatedAt:    subOrder.CreatedAt,
 			UpdatedAt:    subOrder.UpdatedAt,
-			Payment: &orderPaymentDTO.OrderPaymentResponse{
-				PaymentID:   subOrder.Payment.PaymentID,
-				PaymentLink: *subOrder.Payment.PaymentURL,
-				Status:      subOrder.Payment.Status,
-			},
+			Payment:      o.toOrderPaymentResponse(&subOrder.Payment),
 		})
 	}
 
+	return orderResponses
+}
+
+func (o *SubOrder) GetMyOrderList(
+	ctx context.Context,
+	request *subOrderDTO.SubOrderRequestParam,
+) (*helper.PaginationResult, error) {
+	const logCtx = "services.suborder.sub_order.GetMyOrderList"
+	var (
+		user = rbac.GetUserLogin(ctx)
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	subOrders, total, err := o.repository.GetSubOrder().FindAllByCustomerIDWithPagination(ctx, user.UUID.String(), request)
+	if err != nil {
+		return nil, err
+	}
+
 	pagination := helper.PaginationParam{
 		Count: total,
 		Page:  request.Page,
 		Limit: request.Limit,
-		Data:  orderResponses,
+		Data:  o.toSubOrderListResponse(subOrders),
 	}
 	response := helper.GeneratePagination(pagination)
 	return &response, nil
 }
 
+func (o *SubOrder) GetMyOrderDetail(ctx context.Context, subOrderUUID string) (*subOrderDTO.SubOrderResponse, error) {
+	const logCtx = "services.suborder.sub_order.GetMyOrderDetail"
+	var (
+		user = rbac.GetUserLogin(ctx)
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	subOrder, err := o.repository.GetSubOrder().FindOneByUUIDAndCustomerID(ctx, subOrderUUID, user.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toSubOrderDetailResponse(subOrder), nil
+}
+
 func (o *SubOrder) GetOrderDetail(ctx context.Context, subOrderUUID string) (*subOrderDTO.SubOrderResponse, error) {
 	const logCtx = "services.suborder.sub_order.GetOrderDetail"
 	var (
@@ -141,22 +249,24 @@ func (o *SubOrder) GetOrderDetail(ctx context.Context, subOrderUUID string) (*su
 		return nil, err
 	}
 
-	response := &subOrderDTO.SubOrderResponse{
+	return o.toSubOrderDetailResponse(subOrder), nil
+}
+
+func (o *SubOrder) toSubOrderDetailResponse(subOrder *models.SubOrder) *subOrderDTO.SubOrderResponse {
+	return &subOrderDTO.SubOrderResponse{
 		OrderID:      subOrder.Order.UUID,
 		SubOrderID:   subOrder.UUID,
 		SubOrderName: subOrder.SubOrderName,
 		CustomerID:   subOrder.Order.CustomerID,
 		PackageID:    subOrder.Order.PackageID,
 		Amount:       subOrder.Amount,
+		Currency:     subOrder.Currency,
 		Status:       subOrder.Status,
+		OrderDate:    subOrder.OrderDate,
+		DueDate:      subOrder.DueDate,
 		IsPaid:       subOrder.IsPaid,
-		Payment: &orderPaymentDTO.OrderPaymentResponse{
-			PaymentID:   subOrder.Payment.PaymentID,
-			PaymentLink: *subOrder.Payment.PaymentURL,
-			Status:      subOrder.Payment.Status,
-		},
+		Payment:      o.toOrderPaymentResponse(&subOrder.Payment),
 	}
-	return response, nil
 }
 
 func (o *SubOrder) CreateOrder(
@@ -172,13 +282,19 @@ func (o *SubOrder) CreateOrder(
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
 
-	switch request.PaymentType {
-	case constant.PTDownPayment:
-		response, err = o.createDownPaymentOrder(ctx, request)
-	case constant.PTHalfPayment:
-		response, err = o.createHalfPaymentOrder(ctx, request)
-	case constant.PTFullPayment:
-		response, err = o.createFullPaymentOrder(ctx, request)
+	today := time.Now()
+	if today.After(request.OrderDate) {
+		return nil, errorGeneral.ErrOrderDate
+	}
+
+	if request.OrderID != uuid.Nil && request.VoucherCode != "" {
+		return nil, errOrder.ErrVoucherNotApplicable
+	}
+
+	if request.OrderID == uuid.Nil {
+		response, err = o.createFirstInstallmentOrder(ctx, request)
+	} else {
+		response, err = o.createNextInstallmentOrder(ctx, request)
 	}
 	if err != nil {
 		return nil, err
@@ -193,63 +309,88 @@ func (o *SubOrder) randomNumber() int {
 	return number
 }
 
-//nolint:cyclop,gocognit
-func (o *SubOrder) createDownPaymentOrder(
+//nolint:cyclop
+func (o *SubOrder) createFirstInstallmentOrder(
 	ctx context.Context,
 	request *subOrderDTO.SubOrderRequest,
 ) (*subOrderDTO.SubOrderResponse, error) {
-	const logCtx = "services.suborder.sub_order.createDownPaymentOrder"
+	const logCtx = "services.suborder.sub_order.createFirstInstallmentOrder"
 	var (
 		subOrder        *models.SubOrder
 		order           *models.Order
 		txErr           error
-		paymentResponse *paymentClient.PaymentData
+		paymentOutbox   *models.OrderOutbox
+		eventOutbox     *models.OrderOutbox
 		packageResponse *packageClient.PackageData
 		err             error
 		user            = rbac.GetUserLogin(ctx)
 		orderHistories  []orderHistoryDTO.OrderHistoryRequest
-		wg              sync.WaitGroup
-		resultChan      = make(chan ClientResponse, 3)
 		span            = o.sentry.StartSpan(ctx, logCtx)
 	)
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
 
-	today := time.Now()
-	if today.After(request.OrderDate) {
-		return nil, errorGeneral.ErrOrderDate
+	installmentPlan, err := o.installmentPlan.ResolveByPackageID(ctx, request.PackageID.String())
+	if err != nil {
+		return nil, err
 	}
 
 	tx := o.repository.GetTx()
 	err = tx.Transaction(func(tx *gorm.DB) error {
 		packageRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				packageResponse, err = o.client.GetWeddingPackage().GetDetailPackage(ctx, request.PackageID.String())
-				resultChan <- ClientResponse{
-					packageData:  packageResponse,
-					packageError: err,
-				}
-			}()
-
-			result := <-resultChan
-			if result.packageError != nil {
-				txErr = result.packageError
-				return nil, txErr
-			}
-
-			return packageResponse, nil
+			packageResponse, txErr = o.client.GetWeddingPackage().GetDetailPackage(ctx, request.PackageID.String())
+			return packageResponse, txErr
 		})
-		txErr = o.breaker.Execute(ctx, packageRequest)
+		txErr = o.breaker.Execute(ctx, circuitbreaker.Package, packageRequest)
 		if txErr != nil {
 			return txErr
 		}
 
-		total := float64(packageResponse.Price) * float64(packageResponse.MinimalDownPayment) / 100
-		if total != request.Amount {
-			newError := fmt.Errorf("down payment must be %d%% from weddingpackage price", packageResponse.MinimalDownPayment) //nolint:goerr113,lll
-			return newError
+		currency := constant.DefaultCurrency
+		if packageResponse.Currency != "" {
+			currency = constant.Currency(strings.ToUpper(packageResponse.Currency))
+		}
+		if !currency.IsSupported() {
+			return errOrder.ErrUnsupportedCurrency
+		}
+
+		packagePrice := money.New(int64(packageResponse.Price))
+		price := packagePrice
+		promo := o.packagePromo(packageResponse, request.OrderDate, currency)
+		if promo != nil {
+			price -= promo.discount
+		}
+
+		var (
+			voucher         *models.Voucher
+			voucherDiscount money.Money
+		)
+		if request.VoucherCode != "" {
+			voucher, voucherDiscount, txErr = o.voucher.Apply(ctx, tx, &voucherDTO.ApplyVoucherRequest{
+				Code:       request.VoucherCode,
+				CustomerID: request.CustomerID.String(),
+				PackageID:  request.PackageID.String(),
+				Amount:     price,
+				Currency:   currency,
+			})
+			if txErr != nil {
+				return txErr
+			}
+			price -= voucherDiscount
+		}
+
+		item, dueDate, txErr := o.installmentPlan.Validate(ctx, installmentPlan, nil, price,
+			&installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:        request.PaymentType,
+				Amount:             request.Amount,
+				OrderDate:          request.OrderDate,
+				PackagePrice:       price,
+				Currency:           currency,
+				MinimalDownPayment: packageResponse.MinimalDownPayment,
+				IsFirstInstallment: true,
+			})
+		if txErr != nil {
+			return txErr
 		}
 
 		customerID, _ := uuid.Parse(request.CustomerID.String()) //nolint:errcheck
@@ -258,30 +399,64 @@ func (o *SubOrder) createDownPaymentOrder(
 			return txErr
 		}
 
-		if order != nil {
-			if len(order.SubOrder) != 3 || order.CompletedAt == nil {
-				return errOrder.ErrPreviousOrderNotEmpty
-			}
+		if order != nil && order.CompletedAt == nil {
+			return errOrder.ErrPreviousOrderNotEmpty
+		}
+
+		var installmentPlanID *uint
+		if installmentPlan.ID != 0 {
+			installmentPlanID = &installmentPlan.ID
 		}
 
-		order, txErr = o.repository.GetOrder().Create(ctx, tx, &orderDTO.OrderRequest{
+		orderRequest := &orderDTO.OrderRequest{
 			CustomerID:                 request.CustomerID.String(),
 			CustomerName:               user.Name,
 			CustomerEmail:              user.Email,
 			CustomerPhone:              user.PhoneNumber,
 			PackageID:                  request.PackageID.String(),
-			RemainingOutstandingAmount: float64(packageResponse.Price),
-		})
+			PackagePrice:               packagePrice,
+			Currency:                   currency,
+			Locale:                     locale.FromContext(ctx),
+			TotalAmount:                price,
+			RemainingOutstandingAmount: price,
+			InstallmentPlanID:          installmentPlanID,
+		}
+		if promo != nil {
+			orderRequest.PromoID = &promo.id
+			orderRequest.PromoName = &promo.name
+			orderRequest.DiscountAmount = promo.discount
+		}
+		if voucher != nil {
+			orderRequest.VoucherID = &voucher.ID
+			orderRequest.VoucherCode = &voucher.Code
+			orderRequest.VoucherDiscountAmount = voucherDiscount
+		}
+
+		order, txErr = o.repository.GetOrder().Create(ctx, tx, orderRequest)
 		if txErr != nil {
 			return txErr
 		}
 
+		if voucher != nil {
+			txErr = o.voucher.Redeem(ctx, tx, &voucherDTO.RedeemVoucherRequest{
+				VoucherID:      voucher.ID,
+				OrderID:        order.ID,
+				CustomerID:     order.CustomerID,
+				DiscountAmount: voucherDiscount,
+			})
+			if txErr != nil {
+				return txErr
+			}
+		}
+
 		subOrder, txErr = o.repository.GetSubOrder().Create(ctx, tx, &models.SubOrder{
 			OrderID:     order.ID,
 			Status:      constant.Pending,
 			Amount:      request.Amount,
+			Currency:    order.Currency,
 			PaymentType: request.PaymentType,
 			OrderDate:   request.OrderDate,
+			DueDate:     dueDate,
 		})
 		if txErr != nil {
 			return txErr
@@ -298,101 +473,17 @@ func (o *SubOrder) createDownPaymentOrder(
 			return txErr
 		}
 
-		expiredAt := time.Now().Add(24 * time.Hour)
-		paymentRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				paymentResponse, err = o.generatePaymentLink(
-					ctx,
-					subOrder,
-					order,
-					request,
-					expiredAt,
-				)
-				resultChan <- ClientResponse{
-					paymentData:  paymentResponse,
-					paymentError: err,
-				}
-			}()
-
-			result := <-resultChan
-			if result.paymentError != nil {
-				txErr = result.paymentError
-				return nil, txErr
-			}
-
-			return paymentResponse, nil
-		})
-		txErr = o.breaker.Execute(ctx, paymentRequest)
-		if txErr != nil {
-			return txErr
-		}
-
-		txErr = o.repository.GetOrderPayment().
-			Create(ctx, tx, &orderPaymentDTO.OrderPaymentRequest{
-				Amount:      request.Amount,
-				SubOrderID:  subOrder.ID,
-				PaymentID:   paymentResponse.UUID,
-				PaymentLink: paymentResponse.PaymentLink,
-				Status:      paymentResponse.Status,
-				ExpiredAt:   &expiredAt,
-			})
+		paymentOutbox, txErr = o.enqueuePaymentLink(ctx, tx, subOrder, order, item,
+			fmt.Sprintf("%s:%s", constant.OutboxCreatePaymentLink, subOrder.UUID))
 		if txErr != nil {
 			return txErr
 		}
 
-		//nolint:dupl
-		notificationRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			templateID := template.GetTemplateIDByName(constant.Prepaid)
-			expiredDay := expiredAt.Format("02")
-			expiredMonth := helper.ConvertToIndonesianMonth(expiredAt.Format("January"))
-			expiredYear := expiredAt.Format("2006")
-			expiredHour := expiredAt.Format("15:04")
-			expiredAtString := fmt.Sprintf("%s %s %s %s", expiredDay, expiredMonth, expiredYear, expiredHour)
-			whatsappRequest := &notificationClient.NotificationRequest{
-				TemplateID:  *templateID,
-				PhoneNumber: order.CustomerPhone,
-				Data: &notificationClient.SendWhatsappData{
-					OrderID:     subOrder.SubOrderName,
-					Description: constant.PTDownPaymentIndonesianTitle.String(),
-					ExpiredAt:   expiredAtString,
-					Amount:      helper.RupiahFormat(&request.Amount),
-				},
-				Button: &notificationClient.Button{
-					URL: &notificationClient.URL{
-						Display: constant.PrepaidDisplayButton,
-						Link:    paymentResponse.PaymentLink,
-					},
-				},
-			}
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				err = o.sendToWhatsapp(ctx, whatsappRequest)
-				resultChan <- ClientResponse{
-					notificationError: err,
-				}
-			}()
-
-			result := <-resultChan
-			if result.notificationError != nil {
-				txErr = result.notificationError
-				return nil, txErr
-			}
-
-			return nil, nil
-		})
-		txErr = o.breaker.Execute(ctx, notificationRequest)
+		eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.OrderCreated, order, subOrder)
 		if txErr != nil {
 			return txErr
 		}
 
-		go func() {
-			wg.Wait()
-			close(resultChan)
-		}()
-
 		return nil
 	})
 
@@ -400,50 +491,30 @@ func (o *SubOrder) createDownPaymentOrder(
 		return nil, err
 	}
 
-	response := subOrderDTO.SubOrderResponse{
-		OrderID:      order.UUID,
-		SubOrderID:   subOrder.UUID,
-		SubOrderName: subOrder.SubOrderName,
-		CustomerID:   order.CustomerID,
-		PackageID:    order.PackageID,
-		Amount:       subOrder.Amount,
-		Status:       subOrder.Status,
-		OrderDate:    subOrder.OrderDate,
-		IsPaid:       subOrder.IsPaid,
-		Payment: &orderPaymentDTO.OrderPaymentResponse{
-			PaymentID:   paymentResponse.UUID,
-			PaymentLink: paymentResponse.PaymentLink,
-			Status:      paymentResponse.Status,
-		},
-	}
-	return &response, nil
+	metrics.OrdersCreatedTotal.WithLabelValues(string(subOrder.PaymentType)).Inc()
+	o.dispatch(ctx, paymentOutbox, eventOutbox)
+	return o.toSubOrderResponse(ctx, order, subOrder)
 }
 
 //nolint:cyclop
-func (o *SubOrder) createHalfPaymentOrder(
+func (o *SubOrder) createNextInstallmentOrder(
 	ctx context.Context,
 	request *subOrderDTO.SubOrderRequest,
 ) (*subOrderDTO.SubOrderResponse, error) {
-	const logCtx = "services.suborder.sub_order.createHalfPaymentOrder"
+	const logCtx = "services.suborder.sub_order.createNextInstallmentOrder"
 	var (
-		subOrder        *models.SubOrder
-		order           *models.Order
-		txErr           error
-		paymentResponse *paymentClient.PaymentData
-		err             error
-		orderHistories  []orderHistoryDTO.OrderHistoryRequest
-		wg              sync.WaitGroup
-		resultChan      = make(chan ClientResponse, 2)
-		span            = o.sentry.StartSpan(ctx, logCtx)
+		subOrder       *models.SubOrder
+		order          *models.Order
+		txErr          error
+		paymentOutbox  *models.OrderOutbox
+		eventOutbox    *models.OrderOutbox
+		err            error
+		orderHistories []orderHistoryDTO.OrderHistoryRequest
+		span           = o.sentry.StartSpan(ctx, logCtx)
 	)
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
 
-	today := time.Now()
-	if today.After(request.OrderDate) {
-		return nil, errorGeneral.ErrOrderDate
-	}
-
 	order, err = o.repository.GetOrder().FindOneOrderByUUID(ctx, request.OrderID)
 	if err != nil {
 		return nil, err
@@ -453,35 +524,49 @@ func (o *SubOrder) createHalfPaymentOrder(
 		return nil, errOrder.ErrOrderIsEmpty
 	}
 
-	subOrder, err = o.repository.GetSubOrder().
-		FindOneByOrderIDAndPaymentType(
-			ctx,
-			order.ID,
-			constant.PTHalfPayment.String())
-	if err != nil {
-		return nil, err
-	}
+	tx := o.repository.GetTx()
+	err = tx.Transaction(func(tx *gorm.DB) error {
+		// lock the order so concurrent requests for the next installment are validated one after
+		// the other and cannot both create it
+		order, txErr = o.repository.GetOrder().FindOneOrderByIDWithLocking(ctx, tx, order.ID)
+		if txErr != nil {
+			return txErr
+		}
 
-	if subOrder != nil {
-		if *subOrder.IsPaid {
-			return nil, errOrder.ErrHalfPaymentNotEmpty
-		} else { //nolint:revive
-			return nil, errOrder.ErrPreviousOrderNotEmpty
+		if order.DeletedAt != nil && order.DeletedAt.Valid {
+			return errOrder.ErrOrderIsEmpty
+		}
+
+		installmentPlan, txErr := o.installmentPlan.ResolveByOrder(ctx, order)
+		if txErr != nil {
+			return txErr
+		}
+
+		subOrders, txErr := o.repository.GetSubOrder().FindAllByOrderID(ctx, order.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		item, dueDate, txErr := o.installmentPlan.Validate(ctx, installmentPlan, subOrders,
+			order.RemainingOutstandingAmount, &installmentPlanDTO.ValidateInstallmentRequest{
+				PaymentType:  request.PaymentType,
+				Amount:       request.Amount,
+				OrderDate:    request.OrderDate,
+				PackagePrice: order.TotalAmount,
+				Currency:     order.Currency,
+			})
+		if txErr != nil {
+			return txErr
 		}
-	}
 
-	total := order.RemainingOutstandingAmount * 50 / 100
-	if total != request.Amount {
-		return nil, errOrder.ErrInvalidHalfAmount
-	}
-	tx := o.repository.GetTx()
-	err = tx.Transaction(func(tx *gorm.DB) error { //nolint:dupl
 		subOrder, txErr = o.repository.GetSubOrder().Create(ctx, tx, &models.SubOrder{
 			OrderID:     order.ID,
 			Status:      constant.Pending,
 			Amount:      request.Amount,
+			Currency:    order.Currency,
 			PaymentType: request.PaymentType,
 			OrderDate:   request.OrderDate,
+			DueDate:     dueDate,
 		})
 		if txErr != nil {
 			return txErr
@@ -498,101 +583,17 @@ func (o *SubOrder) createHalfPaymentOrder(
 			return txErr
 		}
 
-		expiredAt := time.Now().Add(24 * time.Hour)
-		paymentRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				paymentResponse, err = o.generatePaymentLink(
-					ctx,
-					subOrder,
-					order,
-					request,
-					expiredAt,
-				)
-				resultChan <- ClientResponse{
-					paymentData:  paymentResponse,
-					paymentError: err,
-				}
-			}()
-
-			result := <-resultChan
-			if result.paymentError != nil {
-				txErr = result.paymentError
-				return nil, txErr
-			}
-
-			return paymentResponse, nil
-		})
-		txErr = o.breaker.Execute(ctx, paymentRequest)
-		if txErr != nil {
-			return txErr
-		}
-
-		txErr = o.repository.GetOrderPayment().
-			Create(ctx, tx, &orderPaymentDTO.OrderPaymentRequest{
-				Amount:      request.Amount,
-				SubOrderID:  subOrder.ID,
-				PaymentID:   paymentResponse.UUID,
-				PaymentLink: paymentResponse.PaymentLink,
-				Status:      paymentResponse.Status,
-				ExpiredAt:   &expiredAt,
-			})
+		paymentOutbox, txErr = o.enqueuePaymentLink(ctx, tx, subOrder, order, item,
+			fmt.Sprintf("%s:%s", constant.OutboxCreatePaymentLink, subOrder.UUID))
 		if txErr != nil {
 			return txErr
 		}
 
-		//nolint:dupl
-		notificationRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			templateID := template.GetTemplateIDByName(constant.Prepaid)
-			expiredDay := expiredAt.Format("02")
-			expiredMonth := helper.ConvertToIndonesianMonth(expiredAt.Format("January"))
-			expiredYear := expiredAt.Format("2006")
-			expiredHour := expiredAt.Format("15:04")
-			expiredAtString := fmt.Sprintf("%s %s %s %s", expiredDay, expiredMonth, expiredYear, expiredHour)
-			whatsappRequest := &notificationClient.NotificationRequest{
-				TemplateID:  *templateID,
-				PhoneNumber: order.CustomerPhone,
-				Data: &notificationClient.SendWhatsappData{
-					OrderID:     subOrder.SubOrderName,
-					Description: constant.PTHalfPaymentIndonesianTitle.String(),
-					ExpiredAt:   expiredAtString,
-					Amount:      helper.RupiahFormat(&request.Amount),
-				},
-				Button: &notificationClient.Button{
-					URL: &notificationClient.URL{
-						Display: constant.PrepaidDisplayButton,
-						Link:    paymentResponse.PaymentLink,
-					},
-				},
-			}
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				err = o.sendToWhatsapp(ctx, whatsappRequest)
-				resultChan <- ClientResponse{
-					notificationError: err,
-				}
-			}()
-
-			result := <-resultChan
-			if result.notificationError != nil {
-				txErr = result.notificationError
-				return nil, txErr
-			}
-
-			return nil, nil
-		})
-		txErr = o.breaker.Execute(ctx, notificationRequest)
+		eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.SubOrderCreated, order, subOrder)
 		if txErr != nil {
 			return txErr
 		}
 
-		go func() {
-			wg.Wait()
-			close(resultChan)
-		}()
-
 		return nil
 	})
 
@@ -600,334 +601,707 @@ func (o *SubOrder) createHalfPaymentOrder(
 		return nil, err
 	}
 
-	response := subOrderDTO.SubOrderResponse{
-		OrderID:      order.UUID,
-		SubOrderID:   subOrder.UUID,
-		SubOrderName: subOrder.SubOrderName,
-		CustomerID:   order.CustomerID,
-		PackageID:    order.PackageID,
-		Amount:       subOrder.Amount,
-		Status:       subOrder.Status,
-		OrderDate:    subOrder.OrderDate,
-		IsPaid:       subOrder.IsPaid,
-		Payment: &orderPaymentDTO.OrderPaymentResponse{
-			PaymentID:   paymentResponse.UUID,
-			PaymentLink: paymentResponse.PaymentLink,
-			Status:      paymentResponse.Status,
-		},
-	}
-	return &response, nil
+	o.dispatch(ctx, paymentOutbox, eventOutbox)
+	return o.toSubOrderResponse(ctx, order, subOrder)
 }
 
-//nolint:cyclop
-func (o *SubOrder) createFullPaymentOrder(
+func (o *SubOrder) enqueuePaymentLink(
 	ctx context.Context,
-	request *subOrderDTO.SubOrderRequest,
-) (*subOrderDTO.SubOrderResponse, error) {
-	const logCtx = "services.suborder.sub_order.createFullPaymentOrder"
-	var (
-		subOrder        *models.SubOrder
-		order           *models.Order
-		txErr           error
-		paymentResponse *paymentClient.PaymentData
-		err             error
-		orderHistories  []orderHistoryDTO.OrderHistoryRequest
-		wg              sync.WaitGroup
-		resultChan      = make(chan ClientResponse, 2)
-		span            = o.sentry.StartSpan(ctx, logCtx)
-	)
-	ctx = o.sentry.SpanContext(span)
-	defer o.sentry.Finish(span)
+	tx *gorm.DB,
+	subOrder *models.SubOrder,
+	order *models.Order,
+	item *models.InstallmentPlanItem,
+	idempotencyKey string,
+) (*models.OrderOutbox, error) {
+	expiredAt := time.Now().Add(24 * time.Hour)
+	itemDetails := []paymentClient.ItemDetail{
+		{
+			ID:       uuid.New(),
+			Name:     constant.PaymentTypeTitle(item.Title),
+			Amount:   subOrder.Amount,
+			Quantity: 1,
+		},
+	}
 
-	today := time.Now()
-	if today.After(request.OrderDate) {
-		return nil, errorGeneral.ErrOrderDate
+	for _, discount := range o.discountShares(order, subOrder.Amount, locale.English) {
+		if discount.amount <= 0 {
+			continue
+		}
+
+		itemDetails[0].Amount += discount.amount
+		itemDetails = append(itemDetails, paymentClient.ItemDetail{
+			ID:       uuid.New(),
+			Name:     constant.PaymentTypeTitle(discount.title),
+			Amount:   -discount.amount,
+			Quantity: 1,
+		})
 	}
 
-	order, err = o.repository.GetOrder().FindOneOrderByUUID(ctx, request.OrderID)
-	if err != nil {
-		return nil, err
+	return o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+		SubOrderID:     subOrder.ID,
+		Event:          constant.OutboxCreatePaymentLink,
+		IdempotencyKey: idempotencyKey,
+		Payload: outboxDTO.PaymentLinkPayload{
+			SubOrderName: subOrder.SubOrderName,
+			Description:  order.Locale.Title(item.Title, item.IndonesianTitle),
+			Locale:       order.Locale,
+			Payment: paymentClient.PaymentRequest{
+				OrderID:     subOrder.UUID,
+				ExpiredAt:   expiredAt,
+				Amount:      subOrder.Amount,
+				Currency:    subOrder.Currency,
+				Description: constant.PaymentTypeTitle(item.Title),
+				CustomerDetail: paymentClient.CustomerDetail{
+					Name:  order.CustomerName,
+					Email: order.CustomerEmail,
+					Phone: order.CustomerPhone,
+				},
+				ItemDetail: itemDetails,
+			},
+		},
+	})
+}
+
+type appliedPromo struct {
+	id       int
+	name     string
+	discount money.Money
+}
+
+// packagePromo returns the promo attached to the package when the order date falls inside its
+// window. The package service sends the discount as text, only a value with a % suffix is read as
+// a percentage of the price, any other value is a nominal amount.
+func (o *SubOrder) packagePromo(
+	packageResponse *packageClient.PackageData,
+	orderDate time.Time,
+	currency constant.Currency,
+) *appliedPromo {
+	promo := packageResponse.PackagePromo.Promo
+	if packageResponse.PackagePromo.PromoID == 0 || promo.Discount == "" {
+		return nil
 	}
 
-	if order == nil {
-		return nil, errOrder.ErrOrderIsEmpty
+	startDate, errStart := o.parsePromoDate(promo.StartDate)
+	endDate, errEnd := o.parsePromoDate(promo.EndDate)
+	if errStart != nil || errEnd != nil {
+		log.Warnf("skipping promo %d with invalid window %s - %s", promo.ID, promo.StartDate, promo.EndDate)
+		return nil
 	}
 
-	subOrder, err = o.repository.GetSubOrder().
-		FindOneByOrderIDAndPaymentType(
-			ctx,
-			order.ID,
-			constant.PTFullPayment.String())
+	if len(promo.EndDate) == len("2006-01-02") {
+		endDate = endDate.AddDate(0, 0, 1)
+	}
+	if orderDate.Before(startDate) || !orderDate.Before(endDate) {
+		return nil
+	}
+
+	rawDiscount := strings.TrimSpace(promo.Discount)
+	isPercentage := strings.HasSuffix(rawDiscount, "%")
+	value, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(rawDiscount, "%")), 64)
+	if err != nil || value <= 0 {
+		log.Warnf("skipping promo %d with invalid discount %s", promo.ID, promo.Discount)
+		return nil
+	}
+
+	price := money.New(int64(packageResponse.Price))
+	discount := money.NewFromFloat(value)
+	if isPercentage {
+		discount = price.Percent(value, currency.Decimals())
+	}
+	discount = money.Min(discount, price)
+
+	return &appliedPromo{
+		id:       packageResponse.PackagePromo.PromoID,
+		name:     promo.Name,
+		discount: discount,
+	}
+}
+
+func (o *SubOrder) parsePromoDate(value string) (time.Time, error) {
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	var err error
+	for _, layout := range constant.PromoDateLayouts {
+		var date time.Time
+		date, err = time.ParseInLocation(layout, value, location)
+		if err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+type discountShare struct {
+	title  string
+	amount money.Money
+}
+
+// discountShares spreads the order discounts over its installments in proportion to the amount,
+// so the payment item details still add up to the amount charged.
+func (o *SubOrder) discountShares(order *models.Order, amount money.Money, language locale.Locale) []discountShare {
+	if order.TotalAmount <= 0 {
+		return nil
+	}
+
+	shares := make([]discountShare, 0, 2)
+	if order.DiscountAmount > 0 && order.PromoName != nil {
+		shares = append(shares, discountShare{
+			title:  language.Translate(locale.PromoDiscountTitle, *order.PromoName),
+			amount: order.DiscountAmount.MulDiv(amount, order.TotalAmount, order.Currency.Decimals()),
+		})
+	}
+
+	if order.VoucherDiscountAmount > 0 && order.VoucherCode != nil {
+		shares = append(shares, discountShare{
+			title:  language.Translate(locale.VoucherDiscountTitle, *order.VoucherCode),
+			amount: order.VoucherDiscountAmount.MulDiv(amount, order.TotalAmount, order.Currency.Decimals()),
+		})
+	}
+	return shares
+}
+
+// dispatch delivers the outbox entries right after the transaction is committed, a failure is
+// only logged because the outbox dispatcher keeps retrying the entries in the background.
+func (o *SubOrder) dispatch(ctx context.Context, outboxes ...*models.OrderOutbox) {
+	for _, outbox := range outboxes {
+		if outbox == nil {
+			continue
+		}
+
+		err := o.outbox.Dispatch(ctx, outbox)
+		if err != nil {
+			log.Errorf("failed to dispatch outbox %s, it will be retried: %v", outbox.UUID, err)
+		}
+	}
+}
+
+func (o *SubOrder) enqueueEvent(
+	ctx context.Context,
+	tx *gorm.DB,
+	event kafkaDTO.EventName,
+	order *models.Order,
+	subOrder *models.SubOrder,
+) (*models.OrderOutbox, error) {
+	// the latest history entry identifies the transition, a sub order may go through the same
+	// status more than once and each of those events must be published
+	history, err := o.repository.GetOrderHistory().FindLatestBySubOrderID(ctx, tx, subOrder.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if subOrder != nil {
-		if *subOrder.IsPaid {
-			return nil, errOrder.ErrFullPaymentNotEmpty
-		} else { //nolint:revive
-			return nil, errOrder.ErrPreviousOrderNotEmpty
-		}
+	idempotencyKey := fmt.Sprintf("%s:%s:%s:%d", constant.OutboxPublishEvent, event, subOrder.UUID, history.ID)
+	message, err := json.Marshal(kafkaDTO.KafkaMessage[orderEventDTO.OrderData]{
+		Event: kafkaDTO.KafkaMessageEvent{
+			Name: event,
+		},
+		Meta: kafkaDTO.KafkaMessageMeta{
+			MessageID: &idempotencyKey,
+			Sender:    config.Config.AppName,
+			SendingAt: time.Now(),
+		},
+		Body: kafkaDTO.KafkaMessageBody[orderEventDTO.OrderData]{
+			Type: orderEventDTO.OrderDataType,
+			Data: o.toOrderEventData(order, subOrder),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+		SubOrderID:     subOrder.ID,
+		Event:          constant.OutboxPublishEvent,
+		IdempotencyKey: idempotencyKey,
+		Payload: outboxDTO.EventPayload{
+			Topic:   config.Config.KafkaProducerTopic,
+			Key:     order.UUID.String(),
+			Message: message,
+		},
+	})
+}
+
+func (o *SubOrder) toOrderEventData(order *models.Order, subOrder *models.SubOrder) orderEventDTO.OrderData {
+	var isPaid bool
+	if subOrder.IsPaid != nil {
+		isPaid = *subOrder.IsPaid
 	}
 
-	total := order.RemainingOutstandingAmount - request.Amount
-	if total != 0 {
-		return nil, errOrder.ErrInvalidFullAmount
+	return orderEventDTO.OrderData{
+		OrderID:                    order.UUID,
+		OrderName:                  order.OrderName,
+		SubOrderID:                 subOrder.UUID,
+		SubOrderName:               subOrder.SubOrderName,
+		CustomerID:                 order.CustomerID,
+		PackageID:                  order.PackageID,
+		PaymentType:                subOrder.PaymentType.String(),
+		Amount:                     subOrder.Amount,
+		Currency:                   subOrder.Currency.String(),
+		RemainingOutstandingAmount: order.RemainingOutstandingAmount,
+		Status:                     subOrder.Status.String(),
+		IsPaid:                     isPaid,
+		OrderDate:                  subOrder.OrderDate,
+		CanceledAt:                 subOrder.CanceledAt,
+		CompletedAt:                order.CompletedAt,
 	}
+}
+
+func (o *SubOrder) toSubOrderResponse(
+	ctx context.Context,
+	order *models.Order,
+	subOrder *models.SubOrder,
+) (*subOrderDTO.SubOrderResponse, error) {
+	payment, err := o.repository.GetOrderPayment().FindBySubOrderID(ctx, subOrder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := subOrderDTO.SubOrderResponse{
+		OrderID:      order.UUID,
+		SubOrderID:   subOrder.UUID,
+		SubOrderName: subOrder.SubOrderName,
+		CustomerID:   order.CustomerID,
+		PackageID:    order.PackageID,
+		Amount:       subOrder.Amount,
+		Currency:     subOrder.Currency,
+		Status:       subOrder.Status,
+		OrderDate:    subOrder.OrderDate,
+		DueDate:      subOrder.DueDate,
+		IsPaid:       subOrder.IsPaid,
+		Payment:      o.toOrderPaymentResponse(payment),
+	}
+	return &response, nil
+}
+
+func (o *SubOrder) toOrderPaymentResponse(payment *models.OrderPayment) *orderPaymentDTO.OrderPaymentResponse {
+	if payment == nil || payment.ID == 0 {
+		return nil
+	}
+
+	var paymentLink string
+	if payment.PaymentURL != nil {
+		paymentLink = *payment.PaymentURL
+	}
+
+	return &orderPaymentDTO.OrderPaymentResponse{
+		PaymentID:   payment.PaymentID,
+		PaymentLink: paymentLink,
+		Status:      payment.Status,
+	}
+}
+
+func (o *SubOrder) Cancel(ctx context.Context, subOrderUUID string) error {
+	const logCtx = "services.suborder.sub_order.Cancel"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.repository.GetSubOrder().FindOneByUUID(ctx, subOrderUUID)
+	if err != nil {
+		return err
+	}
+
+	return o.cancel(ctx, order, "")
+}
+
+func (o *SubOrder) CancelMyOrder(ctx context.Context, subOrderUUID string) error {
+	const logCtx = "services.suborder.sub_order.CancelMyOrder"
+	var (
+		user       = rbac.GetUserLogin(ctx)
+		customerID = user.UUID.String()
+		span       = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	order, err := o.repository.GetSubOrder().FindOneByUUIDAndCustomerID(ctx, subOrderUUID, customerID)
+	if err != nil {
+		return err
+	}
+
+	return o.cancel(ctx, order, customerID)
+}
+
+func (o *SubOrder) cancel(ctx context.Context, order *models.SubOrder, customerID string) error {
+	const logCtx = "services.suborder.sub_order.cancel"
+	var (
+		eventOutbox *models.OrderOutbox
+		txErr       error
+		span        = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	if order.Status == constant.Cancelled {
+		return errOrder.ErrCancelOrder
+	}
+
 	tx := o.repository.GetTx()
-	err = tx.Transaction(func(tx *gorm.DB) error { //nolint:dupl
-		subOrder, txErr = o.repository.GetSubOrder().Create(ctx, tx, &models.SubOrder{
-			OrderID:     order.ID,
-			Status:      constant.Pending,
-			Amount:      request.Amount,
-			PaymentType: request.PaymentType,
-			OrderDate:   request.OrderDate,
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		txErr = o.repository.GetSubOrder().Cancel(ctx, tx, &subOrderDTO.CancelRequest{
+			UUID:       order.UUID,
+			CustomerID: customerID,
+			Status:     constant.Cancelled,
+		}, &models.SubOrder{
+			Status: order.Status,
 		})
 		if txErr != nil {
 			return txErr
 		}
 
-		orderHistories = []orderHistoryDTO.OrderHistoryRequest{
-			{
-				SubOrderID: subOrder.ID,
-				Status:     constant.PendingString,
-			},
+		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &orderHistoryDTO.OrderHistoryRequest{
+			SubOrderID: order.ID,
+			Status:     constant.CancelledString,
+		})
+		if txErr != nil {
+			return txErr
 		}
-		txErr = o.repository.GetOrderHistory().BulkCreate(ctx, tx, orderHistories)
+
+		txErr = o.releaseUnpaidOrder(ctx, tx, order.OrderID)
 		if txErr != nil {
 			return txErr
 		}
 
-		expiredAt := time.Now().Add(24 * time.Hour)
-		paymentRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				paymentResponse, err = o.generatePaymentLink(
-					ctx,
-					subOrder,
-					order,
-					request,
-					expiredAt,
-				)
-				resultChan <- ClientResponse{
-					paymentData:  paymentResponse,
-					paymentError: err,
-				}
-			}()
+		canceledAt := time.Now()
+		order.Status = constant.Cancelled
+		order.CanceledAt = &canceledAt
+		eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.SubOrderCancelled, &order.Order, order)
+		if txErr != nil {
+			return txErr
+		}
 
-			result := <-resultChan
-			if result.paymentError != nil {
-				txErr = result.paymentError
-				return nil, txErr
-			}
+		return nil
+	})
 
-			return paymentResponse, nil
-		})
-		txErr = o.breaker.Execute(ctx, paymentRequest)
+	if err != nil {
+		return err
+	}
+
+	o.dispatch(ctx, eventOutbox)
+	return nil
+}
+
+// RegeneratePaymentLink issues a new payment link for the same installment once the previous one has
+// expired or the sub order was cancelled. The previous payment is kept as history and the parent
+// order is restored when the cancellation had released it.
+//
+//nolint:cyclop
+func (o *SubOrder) RegeneratePaymentLink(
+	ctx context.Context,
+	subOrderUUID string,
+) (*subOrderDTO.SubOrderResponse, error) {
+	const logCtx = "services.suborder.sub_order.RegeneratePaymentLink"
+	var (
+		subOrder      *models.SubOrder
+		order         *models.Order
+		paymentOutbox *models.OrderOutbox
+		expireOutbox  *models.OrderOutbox
+		eventOutbox   *models.OrderOutbox
+		txErr         error
+		span          = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	tx := o.repository.GetTx()
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		subOrder, txErr = o.repository.GetSubOrder().FindOneByUUIDWithLocking(ctx, tx, subOrderUUID)
 		if txErr != nil {
 			return txErr
 		}
 
-		txErr = o.repository.GetOrderPayment().
-			Create(ctx, tx, &orderPaymentDTO.OrderPaymentRequest{
-				Amount:      request.Amount,
-				SubOrderID:  subOrder.ID,
-				PaymentID:   paymentResponse.UUID,
-				PaymentLink: paymentResponse.PaymentLink,
-				Status:      paymentResponse.Status,
-				ExpiredAt:   &expiredAt,
-			})
+		payment, txErr := o.repository.GetOrderPayment().FindBySubOrderID(ctx, subOrder.ID)
 		if txErr != nil {
 			return txErr
 		}
 
-		//nolint:dupl
-		notificationRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-			templateID := template.GetTemplateIDByName(constant.Prepaid)
-			expiredDay := expiredAt.Format("02")
-			expiredMonth := helper.ConvertToIndonesianMonth(expiredAt.Format("January"))
-			expiredYear := expiredAt.Format("2006")
-			expiredHour := expiredAt.Format("15:04")
-			expiredAtString := fmt.Sprintf("%s %s %s %s", expiredDay, expiredMonth, expiredYear, expiredHour)
-			whatsappRequest := &notificationClient.NotificationRequest{
-				TemplateID:  *templateID,
-				PhoneNumber: order.CustomerPhone,
-				Data: &notificationClient.SendWhatsappData{
-					OrderID:     subOrder.SubOrderName,
-					Description: constant.PTFullPaymentIndonesianTitle.String(),
-					ExpiredAt:   expiredAtString,
-					Amount:      helper.RupiahFormat(&request.Amount),
-				},
-				Button: &notificationClient.Button{
-					URL: &notificationClient.URL{
-						Display: constant.PrepaidDisplayButton,
-						Link:    paymentResponse.PaymentLink,
-					},
-				},
+		switch subOrder.Status {
+		case constant.Cancelled:
+		case constant.Pending, constant.PendingPayment:
+			if payment == nil || payment.ExpiredAt == nil || payment.ExpiredAt.After(time.Now()) {
+				return errOrder.ErrPaymentLinkNotExpired
+			}
+		default:
+			return errOrder.ErrPaymentLinkNotExpired
+		}
+
+		order, txErr = o.repository.GetOrder().FindOneOrderByIDWithLocking(ctx, tx, subOrder.OrderID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if order.DeletedAt != nil && order.DeletedAt.Valid {
+			customerID, _ := uuid.Parse(order.CustomerID) //nolint:errcheck
+			activeOrder, txErr := o.repository.GetOrder().FindOneOrderByCustomerIDWithLocking(ctx, tx, customerID)
+			if txErr != nil {
+				return txErr
+			}
+
+			if activeOrder != nil && activeOrder.CompletedAt == nil {
+				return errOrder.ErrPreviousOrderNotEmpty
 			}
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				err = o.sendToWhatsapp(ctx, whatsappRequest)
-				resultChan <- ClientResponse{
-					notificationError: err,
+
+			txErr = o.repository.GetOrder().Restore(ctx, tx, order.ID)
+			if txErr != nil {
+				return txErr
+			}
+			order.DeletedAt = nil
+
+			// the voucher was released along with the order, redeem it again since the order keeps
+			// its discount
+			if order.VoucherID != nil {
+				txErr = o.voucher.Redeem(ctx, tx, &voucherDTO.RedeemVoucherRequest{
+					VoucherID:      *order.VoucherID,
+					OrderID:        order.ID,
+					CustomerID:     order.CustomerID,
+					DiscountAmount: order.VoucherDiscountAmount,
+				})
+				if txErr != nil {
+					return txErr
 				}
-			}()
+			}
+		}
 
-			result := <-resultChan
-			if result.notificationError != nil {
-				txErr = result.notificationError
-				return nil, txErr
+		allSubOrder, txErr := o.repository.GetSubOrder().FindAllByOrderID(ctx, order.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, item := range allSubOrder {
+			if item.ID != subOrder.ID && item.PaymentType == subOrder.PaymentType && item.Status != constant.Cancelled {
+				return errOrder.ErrPaymentLinkNotExpired
 			}
+		}
 
-			return nil, nil
+		installmentPlan, txErr := o.installmentPlan.ResolveByOrder(ctx, order)
+		if txErr != nil {
+			return txErr
+		}
+
+		item := o.installmentPlan.FindItem(installmentPlan, subOrder.PaymentType)
+		if item == nil {
+			return errOrder.ErrInstallmentNotInPlan
+		}
+
+		if subOrder.Status != constant.Pending {
+			txErr = o.repository.GetSubOrder().Reopen(ctx, tx, subOrder)
+			if txErr != nil {
+				return txErr
+			}
+			subOrder.Status = constant.Pending
+			subOrder.CanceledAt = nil
+		}
+
+		// every regenerated link is recorded in the history, even for a sub order that stays
+		// pending, so its reopened event gets a key of its own
+		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &orderHistoryDTO.OrderHistoryRequest{
+			SubOrderID: subOrder.ID,
+			Status:     constant.PendingString,
 		})
-		txErr = o.breaker.Execute(ctx, notificationRequest)
 		if txErr != nil {
 			return txErr
 		}
 
-		go func() {
-			wg.Wait()
-			close(resultChan)
-		}()
+		// a cancelled installment may still have a live link, void it once the transaction is
+		// committed so the customer cannot pay both the previous and the regenerated link
+		if payment != nil && payment.PaidAt == nil && (payment.ExpiredAt == nil || payment.ExpiredAt.After(time.Now())) {
+			expireOutbox, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+				SubOrderID:     subOrder.ID,
+				Event:          constant.OutboxExpirePaymentLink,
+				IdempotencyKey: fmt.Sprintf("%s:%s", constant.OutboxExpirePaymentLink, payment.PaymentID),
+				Payload:        outboxDTO.ExpirePaymentLinkPayload{PaymentID: payment.PaymentID},
+			})
+			if txErr != nil {
+				return txErr
+			}
+		}
 
-		return nil
-	})
+		txErr = o.repository.GetOrderPayment().SupersedeBySubOrderID(ctx, tx, subOrder.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := fmt.Sprintf("%s:%s", constant.OutboxCreatePaymentLink, subOrder.UUID)
+		if payment != nil {
+			idempotencyKey = fmt.Sprintf("%s:%s", idempotencyKey, payment.PaymentID)
+		}
+		paymentOutbox, txErr = o.enqueuePaymentLink(ctx, tx, subOrder, order, item, idempotencyKey)
+		if txErr != nil {
+			return txErr
+		}
 
+		eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.SubOrderReopened, order, subOrder)
+		return txErr
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := subOrderDTO.SubOrderResponse{
-		OrderID:      order.UUID,
-		SubOrderID:   subOrder.UUID,
-		SubOrderName: subOrder.SubOrderName,
-		CustomerID:   order.CustomerID,
-		PackageID:    order.PackageID,
-		Amount:       subOrder.Amount,
-		Status:       subOrder.Status,
-		OrderDate:    subOrder.OrderDate,
-		IsPaid:       subOrder.IsPaid,
-		Payment: &orderPaymentDTO.OrderPaymentResponse{
-			PaymentID:   paymentResponse.UUID,
-			PaymentLink: paymentResponse.PaymentLink,
-			Status:      paymentResponse.Status,
-		},
-	}
-	return &response, nil
+	o.dispatch(ctx, expireOutbox, paymentOutbox, eventOutbox)
+	return o.toSubOrderResponse(ctx, order, subOrder)
 }
 
-func (o *SubOrder) sendToWhatsapp(
-	ctx context.Context,
-	payload *notificationClient.NotificationRequest,
-) error {
-	err := o.client.GetNotification().SendToWhatsapp(ctx, payload)
+// releaseUnpaidOrder releases an order without any paid installment along with its voucher
+// redemption so the customer is able to order again, otherwise the order is kept and the customer
+// only has to recreate the cancelled installment.
+func (o *SubOrder) releaseUnpaidOrder(ctx context.Context, tx *gorm.DB, orderID uint) error {
+	allSubOrder, err := o.repository.GetSubOrder().FindAllByOrderID(ctx, orderID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	for _, item := range allSubOrder {
+		if item.IsPaid != nil && *item.IsPaid {
+			return nil
+		}
+	}
+
+	err = o.voucher.Release(ctx, tx, orderID)
+	if err != nil {
+		return err
+	}
+
+	return o.repository.GetOrder().DeleteByOrderID(ctx, tx, orderID)
 }
 
-func (o *SubOrder) generatePaymentLink(
-	ctx context.Context,
-	subOrder *models.SubOrder,
-	order *models.Order,
-	request *subOrderDTO.SubOrderRequest,
-	expiredAt time.Time,
-) (*paymentClient.PaymentData, error) {
-	paymentResponse, err := o.client.GetPayment().CreatePaymentLink(ctx, &paymentClient.PaymentRequest{
-		OrderID:     subOrder.UUID,
-		ExpiredAt:   expiredAt,
-		Amount:      request.Amount,
-		Description: request.PaymentType.Title(),
-		CustomerDetail: paymentClient.CustomerDetail{
-			Name:  order.CustomerName,
-			Email: order.CustomerEmail,
-			Phone: order.CustomerPhone,
-		},
-		ItemDetail: []paymentClient.ItemDetail{
-			{
-				ID:       uuid.New(),
-				Name:     request.PaymentType.Title(),
-				Amount:   request.Amount,
-				Quantity: 1,
-			},
-		},
-	})
+// ExpireOverdue cancels the sub orders whose payment link has expired without the payment service
+// sending the expire event, it returns the number of sub orders that were cancelled.
+func (o *SubOrder) ExpireOverdue(ctx context.Context) (int, error) {
+	const logCtx = "services.suborder.sub_order.ExpireOverdue"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	batchSize := config.Config.ExpirySweeper.BatchSize
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+
+	gracePeriod := time.Duration(config.Config.ExpirySweeper.GracePeriodInSecond) * time.Second
+	if gracePeriod <= 0 {
+		gracePeriod = 5 * time.Minute
+	}
+
+	subOrders, err := o.repository.GetSubOrder().FindAllExpired(ctx, time.Now().Add(-gracePeriod), batchSize)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return paymentResponse, nil
+	var expired int
+	for i := range subOrders {
+		settled, errStatus := o.isSettledRemotely(ctx, &subOrders[i])
+		if errStatus != nil {
+			log.Errorf("error checking payment status of sub order %s: %v", subOrders[i].UUID, errStatus)
+			continue
+		}
+
+		if settled {
+			log.Infof("skipping expiry of sub order %s, its payment is settled", subOrders[i].UUID)
+			continue
+		}
+
+		err = o.expire(ctx, &subOrders[i])
+		if err != nil {
+			log.Errorf("error expiring sub order %s: %v", subOrders[i].UUID, err)
+			continue
+		}
+		expired++
+	}
+	return expired, nil
 }
 
-func (o *SubOrder) generateInvoice(
-	ctx context.Context,
-	request *invoiceModel.InvoiceRequest,
-) (*invoiceModel.InvoiceData, error) {
-	invoiceResponse, err := o.client.GetInvoice().GenerateInvoice(ctx, request)
+// isSettledRemotely asks the payment service for the payment of the sub order, a settlement that
+// has not reached us yet must not be cancelled by the sweeper.
+func (o *SubOrder) isSettledRemotely(ctx context.Context, subOrder *models.SubOrder) (bool, error) {
+	payment, err := o.repository.GetOrderPayment().FindBySubOrderID(ctx, subOrder.ID)
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+
+	if payment == nil {
+		return false, nil
 	}
 
-	return invoiceResponse, nil
+	var remote *paymentClient.PaymentData
+	request := circuitbreaker.BreakerFunc(func() (interface{}, error) {
+		remote, err = o.client.GetPayment().GetPaymentStatus(ctx, payment.PaymentID)
+		return remote, err
+	})
+	err = o.breaker.Execute(ctx, circuitbreaker.Payment, request)
+	if err != nil {
+		return false, err
+	}
+
+	return remote.Status != nil && strings.EqualFold(*remote.Status, constant.PaymentStatusSettlement.String()), nil
 }
 
-func (o *SubOrder) Cancel(ctx context.Context, subOrderUUID string) error {
-	const logCtx = "services.suborder.sub_order.Cancel"
+func (o *SubOrder) expire(ctx context.Context, subOrder *models.SubOrder) error {
+	const logCtx = "services.suborder.sub_order.expire"
 	var (
-		order *models.SubOrder
-		txErr error
-		span  = o.sentry.StartSpan(ctx, logCtx)
+		eventOutbox *models.OrderOutbox
+		span        = o.sentry.StartSpan(ctx, logCtx)
 	)
 	ctx = o.sentry.SpanContext(span)
 	defer o.sentry.Finish(span)
 
 	tx := o.repository.GetTx()
 	err := tx.Transaction(func(tx *gorm.DB) error {
-		order, txErr = o.repository.GetSubOrder().FindOneByUUID(ctx, subOrderUUID)
+		current, txErr := o.repository.GetSubOrder().FindOneByUUIDWithLocking(ctx, tx, subOrder.UUID.String())
 		if txErr != nil {
 			return txErr
 		}
 
-		if order != nil && order.Status == constant.Cancelled {
-			return errOrder.ErrCancelOrder
+		if current.Status != constant.Pending && current.Status != constant.PendingPayment {
+			return nil
 		}
 
-		uuidParse, _ := uuid.Parse(subOrderUUID) //nolint:errcheck
 		txErr = o.repository.GetSubOrder().Cancel(ctx, tx, &subOrderDTO.CancelRequest{
-			UUID:   uuidParse,
+			UUID:   current.UUID,
 			Status: constant.Cancelled,
 		}, &models.SubOrder{
-			Status: order.Status,
+			Status: current.Status,
 		})
 		if txErr != nil {
 			return txErr
 		}
 
 		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &orderHistoryDTO.OrderHistoryRequest{
-			SubOrderID: order.ID,
+			SubOrderID: current.ID,
 			Status:     constant.CancelledString,
 		})
 		if txErr != nil {
 			return txErr
 		}
 
-		txErr = o.repository.GetOrder().DeleteByOrderID(ctx, tx, order.OrderID)
+		txErr = o.repository.GetOrderPayment().
+			UpdateStatusBySubOrderID(ctx, tx, current.ID, constant.PaymentStatusExpire.String())
+		if txErr != nil {
+			return txErr
+		}
+
+		order, txErr := o.repository.GetOrder().FindOneOrderByID(ctx, current.OrderID)
 		if txErr != nil {
 			return txErr
 		}
 
-		return nil
-	})
+		txErr = o.releaseUnpaidOrder(ctx, tx, current.OrderID)
+		if txErr != nil {
+			return txErr
+		}
 
+		canceledAt := time.Now()
+		current.Status = constant.Cancelled
+		current.CanceledAt = &canceledAt
+		eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.SubOrderCancelled, order, current)
+		return txErr
+	})
 	if err != nil {
 		return err
 	}
 
+	o.dispatch(ctx, eventOutbox)
 	return nil
 }
 
@@ -941,14 +1315,14 @@ func (o *SubOrder) processPayment(
 	var (
 		updateRequest       subOrderDTO.UpdateSubOrderRequest
 		paymentResult       *models.OrderPayment
-		invoiceResponse     *invoiceModel.InvoiceData
+		invoiceOutbox       *models.OrderOutbox
+		eventOutboxes       []*models.OrderOutbox
 		allSubOrder         []models.SubOrder
 		paidAt, completedAt *time.Time
 		isPaid              = false
+		isSkipped           = false
 		order               *models.Order
-		total               float64
-		wg                  sync.WaitGroup
-		resultChan          = make(chan ClientResponse, 2)
+		total               money.Money
 		span                = o.sentry.StartSpan(ctx, logCtx)
 	)
 	ctx = o.sentry.SpanContext(span)
@@ -964,8 +1338,66 @@ func (o *SubOrder) processPayment(
 		return err
 	}
 
+	installmentPlan, err := o.installmentPlan.ResolveByOrder(ctx, order)
+	if err != nil {
+		return err
+	}
+
 	tx := o.repository.GetTx()
 	err = tx.Transaction(func(tx *gorm.DB) error {
+		recorded, txErr := o.repository.GetProcessedEvent().Create(ctx, tx, &processedEventDTO.ProcessedEventRequest{
+			EventKey:  o.paymentEventKey(request),
+			Event:     request.Event,
+			ExpiredAt: time.Now().Add(o.dedupWindow()),
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		if !recorded {
+			return errorGeneral.ErrDuplicateEvent
+		}
+
+		// lock the sub order, the order and the payment in the same order as RegeneratePaymentLink,
+		// concurrent settlements of the installments then decrement the remaining outstanding
+		// amount one after the other and a link cannot be replaced while its callback is applied
+		lockedSubOrder, txErr := o.repository.GetSubOrder().FindOneByUUIDWithLocking(ctx, tx, subOrder.UUID.String())
+		if txErr != nil {
+			return txErr
+		}
+		subOrder.Status = lockedSubOrder.Status
+
+		order, txErr = o.repository.GetOrder().FindOneOrderByIDWithLocking(ctx, tx, subOrder.OrderID)
+		if txErr != nil {
+			return txErr
+		}
+
+		payment, txErr := o.repository.GetOrderPayment().FindByPaymentID(ctx, tx, request.PaymentID.String())
+		if txErr != nil {
+			return txErr
+		}
+
+		if payment.SupersededAt != nil {
+			if status == constant.PaymentSuccess {
+				// the customer paid a link that was replaced, fail the callback so it is parked and
+				// refunded or applied by hand instead of being acknowledged
+				log.Errorf("settlement received for superseded payment %s of sub order %s",
+					request.PaymentID, subOrder.UUID)
+				o.sentry.CaptureException(errOrder.ErrSupersededPaymentPaid)
+				return errOrder.ErrSupersededPaymentPaid
+			}
+
+			log.Infof("skipping %s event for superseded payment %s", request.Event, request.PaymentID)
+			isSkipped = true
+			return nil
+		}
+
+		if subOrder.Status == status {
+			log.Infof("skipping %s event for sub order %s, it is already %s", request.Event, subOrder.UUID, status)
+			isSkipped = true
+			return nil
+		}
+
 		switch status {
 		case constant.PaymentSuccess:
 			isPaid = true
@@ -991,7 +1423,7 @@ func (o *SubOrder) processPayment(
 			return errorGeneral.ErrStatus
 		}
 
-		txErr := o.repository.GetSubOrder().Update(ctx, tx, &updateRequest, &models.SubOrder{
+		txErr = o.repository.GetSubOrder().Update(ctx, tx, &updateRequest, &models.SubOrder{
 			UUID:   subOrder.UUID,
 			Status: subOrder.Status,
 		})
@@ -1023,13 +1455,40 @@ func (o *SubOrder) processPayment(
 			return txErr
 		}
 
+		subOrder.Status = updateRequest.Status
+		subOrder.CanceledAt = updateRequest.CanceledAt
+		if updateRequest.IsPaid != nil {
+			subOrder.IsPaid = updateRequest.IsPaid
+		}
+
+		var event kafkaDTO.EventName
+		switch status {
+		case constant.PaymentSuccess:
+			event = orderEventDTO.SubOrderPaid
+			order.RemainingOutstandingAmount = total
+		case constant.Cancelled:
+			event = orderEventDTO.SubOrderCancelled
+			txErr = o.releaseUnpaidOrder(ctx, tx, order.ID)
+			if txErr != nil {
+				return txErr
+			}
+		case constant.PendingPayment:
+			event = orderEventDTO.SubOrderPendingPayment
+		}
+
+		eventOutbox, txErr := o.enqueueEvent(ctx, tx, event, order, subOrder)
+		if txErr != nil {
+			return txErr
+		}
+		eventOutboxes = append(eventOutboxes, eventOutbox)
+
 		if request.Status == constant.PaymentStatusSettlement.String() {
 			updateOrder := &orderDTO.OrderRequest{
 				OrderID:                    order.UUID.String(),
 				RemainingOutstandingAmount: total,
 			}
 
-			if subOrder.PaymentType == constant.PTFullPayment {
+			if total <= 0 {
 				updateOrder.CompletedAt = completedAt
 			}
 
@@ -1038,6 +1497,15 @@ func (o *SubOrder) processPayment(
 				return txErr
 			}
 
+			if updateOrder.CompletedAt != nil {
+				order.CompletedAt = updateOrder.CompletedAt
+				eventOutbox, txErr = o.enqueueEvent(ctx, tx, orderEventDTO.OrderCompleted, order, subOrder)
+				if txErr != nil {
+					return txErr
+				}
+				eventOutboxes = append(eventOutboxes, eventOutbox)
+			}
+
 			paymentResult, txErr = o.repository.GetOrderPayment().FindByPaymentID(ctx, tx, request.PaymentID.String())
 			if txErr != nil {
 				return txErr
@@ -1047,23 +1515,46 @@ func (o *SubOrder) processPayment(
 			if txErr != nil {
 				return txErr
 			}
-			items := make([]invoiceModel.Item, 0, len(allSubOrder))
-			var totalPrice float64
+			items := make([]invoiceModel.Item, 0, len(allSubOrder)+2)
+			var (
+				totalPrice money.Money
+				discounts  []discountShare
+			)
 			for i := 0; i < len(allSubOrder); i++ {
-				var indonesianTitle string
-				switch allSubOrder[i].PaymentType {
-				case constant.PTDownPayment:
-					indonesianTitle = constant.PTDownPaymentIndonesianTitle.String()
-				case constant.PTHalfPayment:
-					indonesianTitle = constant.PTHalfPaymentIndonesianTitle.String()
-				case constant.PTFullPayment:
-					indonesianTitle = constant.PTFullPaymentIndonesianTitle.String()
+				title := order.Locale.PaymentTypeTitle(allSubOrder[i].PaymentType)
+				if item := o.installmentPlan.FindItem(installmentPlan, allSubOrder[i].PaymentType); item != nil {
+					title = order.Locale.Title(item.Title, item.IndonesianTitle)
+				}
+
+				price := allSubOrder[i].Amount
+				shares := o.discountShares(order, allSubOrder[i].Amount, order.Locale)
+				if discounts == nil {
+					discounts = make([]discountShare, len(shares))
+					copy(discounts, shares)
+				} else {
+					for j := range shares {
+						discounts[j].amount += shares[j].amount
+					}
+				}
+				for _, share := range shares {
+					price += share.amount
 				}
 
 				totalPrice += allSubOrder[i].Amount
 				items = append(items, invoiceModel.Item{
-					Description: indonesianTitle,
-					Price:       helper.RupiahFormat(&allSubOrder[i].Amount),
+					Description: title,
+					Price:       helper.CurrencyFormat(&price, order.Currency),
+				})
+			}
+
+			for i := range discounts {
+				if discounts[i].amount <= 0 {
+					continue
+				}
+
+				items = append(items, invoiceModel.Item{
+					Description: discounts[i].title,
+					Price:       fmt.Sprintf("-%s", helper.CurrencyFormat(&discounts[i].amount, order.Currency)),
 				})
 			}
 
@@ -1073,118 +1564,82 @@ func (o *SubOrder) processPayment(
 				isPaid = false
 			}
 			invoiceNumber := fmt.Sprintf("INV/%s/ORD/%d", time.Now().Format("20060102"), o.randomNumber())
-			invoiceRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-				paidDay := paymentResult.PaidAt.Format("02")
-				paidMonth := helper.ConvertToIndonesianMonth(paymentResult.PaidAt.Format("January"))
-				paidYear := paymentResult.PaidAt.Format("2006")
-				paymentMethod := helper.Ucwords(strings.ReplaceAll(*paymentResult.PaymentType, "_", " "))
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					invoiceResponse, err = o.generateInvoice(
-						ctx,
-						&invoiceModel.InvoiceRequest{
-							InvoiceNumber: invoiceNumber,
-							TemplateID:    config.Config.InternalService.Invoice.TemplateID,
-							CreatedBy:     order.CustomerID,
-							Data: invoiceModel.Data{
-								Customer: invoiceModel.Customer{
-									Name:        order.CustomerName,
-									Email:       order.CustomerEmail,
-									PhoneNumber: order.CustomerPhone,
-								},
-								PaymentDetail: invoiceModel.PaymentDetail{
-									PaymentMethod:              paymentMethod,
-									BankName:                   strings.ToUpper(*paymentResult.Bank),
-									VaNumber:                   *paymentResult.VANumber,
-									RemainingOutstandingAmount: helper.RupiahFormat(&total),
-									Date:                       fmt.Sprintf("%s %s %s", paidDay, paidMonth, paidYear),
-									IsPaid:                     isPaid,
-								},
-								Items: items,
-								Total: helper.RupiahFormat(&totalPrice),
+			paymentMethod := helper.Ucwords(strings.ReplaceAll(*paymentResult.PaymentType, "_", " "))
+			invoiceOutbox, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+				SubOrderID:     subOrder.ID,
+				Event:          constant.OutboxGenerateInvoice,
+				IdempotencyKey: fmt.Sprintf("%s:%s", constant.OutboxGenerateInvoice, request.PaymentID),
+				Payload: outboxDTO.InvoicePayload{
+					Invoice: invoiceModel.InvoiceRequest{
+						InvoiceNumber: invoiceNumber,
+						TemplateID:    config.Config.InternalService.Invoice.TemplateID,
+						CreatedBy:     order.CustomerID,
+						Data: invoiceModel.Data{
+							Customer: invoiceModel.Customer{
+								Name:        order.CustomerName,
+								Email:       order.CustomerEmail,
+								PhoneNumber: order.CustomerPhone,
 							},
-						},
-					)
-					resultChan <- ClientResponse{
-						invoiceData:  invoiceResponse,
-						invoiceError: err,
-					}
-				}()
-
-				result := <-resultChan
-				if result.invoiceError != nil {
-					txErr = result.invoiceError
-					return nil, txErr
-				}
-
-				return invoiceResponse, nil
-			})
-			txErr = o.breaker.Execute(ctx, invoiceRequest)
-			if txErr != nil {
-				return txErr
-			}
-
-			txErr = o.repository.GetOrderInvoice().Create(ctx, tx, &models.OrderInvoice{
-				SubOrderID:    subOrder.ID,
-				InvoiceID:     invoiceResponse.UUID,
-				InvoiceNumber: invoiceNumber,
-				InvoiceURL:    invoiceResponse.URL,
-			})
-			if txErr != nil {
-				return txErr
-			}
-
-			notificationRequest := circuitbreaker.BreakerFunc(func() (interface{}, error) {
-				templateID := template.GetTemplateIDByName(constant.Postpaid)
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					err = o.sendToWhatsapp(
-						ctx,
-						&notificationClient.NotificationRequest{
-							TemplateID:  *templateID,
-							PhoneNumber: order.CustomerPhone,
-							Button: &notificationClient.Button{
-								URL: &notificationClient.URL{
-									Display: constant.InvoiceButton,
-									Link:    invoiceResponse.URL,
-								},
+							PaymentDetail: invoiceModel.PaymentDetail{
+								PaymentMethod:              paymentMethod,
+								BankName:                   strings.ToUpper(*paymentResult.Bank),
+								VaNumber:                   *paymentResult.VANumber,
+								RemainingOutstandingAmount: helper.CurrencyFormat(&total, order.Currency),
+								Date:                       order.Locale.Date(*paymentResult.PaidAt),
+								IsPaid:                     isPaid,
 							},
-						})
-					resultChan <- ClientResponse{
-						notificationError: err,
-					}
-				}()
-
-				result := <-resultChan
-				if result.notificationError != nil {
-					txErr = result.notificationError
-					return nil, txErr
-				}
-
-				return nil, nil
+							Items:    items,
+							Total:    helper.CurrencyFormat(&totalPrice, order.Currency),
+							Currency: order.Currency.String(),
+							Locale:   order.Locale.String(),
+						},
+					},
+				},
 			})
-			txErr = o.breaker.Execute(ctx, notificationRequest)
 			if txErr != nil {
 				return txErr
 			}
-
-			go func() {
-				wg.Wait()
-				close(resultChan)
-			}()
 		}
 
 		return nil
 	})
 	if err != nil {
+		if errors.Is(err, errorGeneral.ErrDuplicateEvent) {
+			log.Infof("skipping duplicate %s event for payment %s", request.Event, request.PaymentID)
+			return nil
+		}
 		return err
 	}
 
+	if isSkipped {
+		return nil
+	}
+
+	if status == constant.PaymentSuccess {
+		metrics.SettlementsTotal.WithLabelValues(string(subOrder.PaymentType)).Inc()
+	}
+	o.dispatch(ctx, append(eventOutboxes, invoiceOutbox)...)
 	return nil
 }
 
+// paymentEventKey identifies a callback by the payment id and event name, the message id is not
+// used since the reconciliation job replays callbacks without one and both must resolve to the
+// same key.
+func (o *SubOrder) paymentEventKey(request *subOrderDTO.PaymentRequest) string {
+	event := request.Event
+	if event == "" {
+		event = request.Status
+	}
+	return fmt.Sprintf("payment:%s:%s", request.PaymentID, event)
+}
+
+func (o *SubOrder) dedupWindow() time.Duration {
+	if config.Config.KafkaDedupWindowInHour <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(config.Config.KafkaDedupWindowInHour) * time.Hour
+}
+
 func (o *SubOrder) ReceivePendingPayment(ctx context.Context, request *subOrderDTO.PaymentRequest) error {
 	return o.processPayment(ctx, request, constant.PendingPayment)
 }
@@ -1196,3 +1651,113 @@ func (o *SubOrder) ReceivePaymentSettlement(ctx context.Context, request *subOrd
 func (o *SubOrder) ReceivePaymentExpire(ctx context.Context, request *subOrderDTO.PaymentRequest) error {
 	return o.processPayment(ctx, request, constant.Cancelled)
 }
+
+//nolint:cyclop
+func (o *SubOrder) Refund(
+	ctx context.Context,
+	subOrderUUID string,
+	request *orderRefundDTO.RefundRequest,
+) (*orderRefundDTO.RefundResponse, error) {
+	const logCtx = "services.suborder.sub_order.Refund"
+	var (
+		subOrder     *models.SubOrder
+		refund       *models.OrderRefund
+		refundOutbox *models.OrderOutbox
+		txErr        error
+		user         = rbac.GetUserLogin(ctx)
+		span         = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	tx := o.repository.GetTx()
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		subOrder, txErr = o.repository.GetSubOrder().FindOneByUUIDWithLocking(ctx, tx, subOrderUUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if subOrder.Status != constant.PaymentSuccess && subOrder.Status != constant.PartiallyRefunded {
+			return errOrder.ErrRefundNotAllowed
+		}
+
+		payment, txErr := o.repository.GetOrderPayment().FindBySubOrderID(ctx, subOrder.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if payment == nil || payment.PaidAt == nil {
+			return errOrder.ErrRefundNotAllowed
+		}
+
+		refunds, txErr := o.repository.GetOrderRefund().FindAllBySubOrderID(ctx, tx, subOrder.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		refundable := payment.Amount
+		for _, orderRefund := range refunds {
+			if orderRefund.Status != constant.RefundFailed {
+				refundable -= orderRefund.Amount
+			}
+		}
+
+		amount := refundable
+		if request.Amount != nil {
+			amount = *request.Amount
+		}
+
+		if amount <= 0 || amount > refundable {
+			return errOrder.ErrInvalidRefundAmount
+		}
+
+		refund, txErr = o.repository.GetOrderRefund().Create(ctx, tx, &orderRefundDTO.OrderRefundRequest{
+			SubOrderID: subOrder.ID,
+			Amount:     amount,
+			Reason:     request.Reason,
+			CreatedBy:  user.UUID.String(),
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		refundOutbox, txErr = o.repository.GetOrderOutbox().Create(ctx, tx, &outboxDTO.OutboxRequest{
+			SubOrderID:     subOrder.ID,
+			Event:          constant.OutboxRefundPayment,
+			IdempotencyKey: fmt.Sprintf("%s:%s", constant.OutboxRefundPayment, refund.UUID),
+			Payload: outboxDTO.RefundPayload{
+				RefundID:  refund.UUID,
+				PaymentID: payment.PaymentID,
+				Refund: paymentClient.RefundRequest{
+					Amount: amount,
+					Reason: request.Reason,
+				},
+			},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o.dispatch(ctx, refundOutbox)
+	refund, err = o.repository.GetOrderRefund().FindOneByUUID(ctx, refund.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderRefundDTO.RefundResponse{
+		RefundID:      refund.UUID,
+		SubOrderID:    subOrder.UUID,
+		Amount:        refund.Amount,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		CreditNoteURL: refund.CreditNoteURL,
+		RefundedAt:    refund.RefundedAt,
+		CreatedAt:     refund.CreatedAt,
+	}, nil
+}
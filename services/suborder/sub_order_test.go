@@ -0,0 +1,171 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	packageClient "order-service/clients/weddingpackage"
+	"order-service/common/money"
+	"order-service/constant"
+	subOrderDTO "order-service/domain/dto/suborder"
+)
+
+func TestPackagePromo(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Jakarta") //nolint:errcheck
+	orderDate := time.Date(2024, time.March, 15, 10, 0, 0, 0, location)
+
+	newPackage := func(price int, discount, startDate, endDate string) *packageClient.PackageData {
+		return &packageClient.PackageData{
+			Price: price,
+			PackagePromo: packageClient.PackagePromo{
+				PromoID: 7,
+				Promo: packageClient.Promo{
+					ID:        7,
+					Name:      "Early Bird",
+					StartDate: startDate,
+					EndDate:   endDate,
+					Discount:  discount,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		packageData  *packageClient.PackageData
+		currency     constant.Currency
+		wantApplied  bool
+		wantDiscount money.Money
+	}{
+		{
+			name:         "percentage",
+			packageData:  newPackage(4500000, "10%", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(450000),
+		},
+		{
+			name:         "percentage rounded to the currency",
+			packageData:  newPackage(4500005, "10%", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(450001),
+		},
+		{
+			name:         "percentage keeps cents",
+			packageData:  newPackage(1005, "10%", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyUSD,
+			wantApplied:  true,
+			wantDiscount: money.NewFromMinor(10050),
+		},
+		{
+			name:         "small nominal without suffix",
+			packageData:  newPackage(4500000, "50", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(50),
+		},
+		{
+			name:         "nominal",
+			packageData:  newPackage(4500000, "500000", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(500000),
+		},
+		{
+			name:         "nominal capped at the price",
+			packageData:  newPackage(400000, "500000", "2024-03-01", "2024-03-31"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(400000),
+		},
+		{
+			name:         "end date is inclusive",
+			packageData:  newPackage(4500000, "10%", "2024-03-01", "2024-03-15"),
+			currency:     constant.CurrencyIDR,
+			wantApplied:  true,
+			wantDiscount: money.New(450000),
+		},
+		{
+			name:        "before the window",
+			packageData: newPackage(4500000, "10%", "2024-03-16", "2024-03-31"),
+			currency:    constant.CurrencyIDR,
+		},
+		{
+			name:        "after the window",
+			packageData: newPackage(4500000, "10%", "2024-03-01", "2024-03-14"),
+			currency:    constant.CurrencyIDR,
+		},
+		{
+			name:        "invalid discount",
+			packageData: newPackage(4500000, "ten", "2024-03-01", "2024-03-31"),
+			currency:    constant.CurrencyIDR,
+		},
+		{
+			name:        "invalid window",
+			packageData: newPackage(4500000, "10%", "March", "2024-03-31"),
+			currency:    constant.CurrencyIDR,
+		},
+		{
+			name:        "no promo",
+			packageData: &packageClient.PackageData{Price: 4500000},
+			currency:    constant.CurrencyIDR,
+		},
+	}
+
+	service := &SubOrder{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.packagePromo(tt.packageData, orderDate, tt.currency)
+			if (got != nil) != tt.wantApplied {
+				t.Fatalf("packagePromo() applied = %v, want %v", got != nil, tt.wantApplied)
+			}
+			if got != nil && got.discount != tt.wantDiscount {
+				t.Errorf("packagePromo() discount = %s, want %s", got.discount, tt.wantDiscount)
+			}
+		})
+	}
+}
+
+func TestPaymentEventKey(t *testing.T) {
+	paymentID := uuid.MustParse("0b8f4a52-2f4e-4b53-9d8a-3f0c1c6a9e11")
+	messageID := "message-1"
+
+	tests := []struct {
+		name    string
+		request *subOrderDTO.PaymentRequest
+		want    string
+	}{
+		{
+			name:    "event",
+			request: &subOrderDTO.PaymentRequest{PaymentID: paymentID, Event: "payment_settlement", Status: "settlement"},
+			want:    "payment:0b8f4a52-2f4e-4b53-9d8a-3f0c1c6a9e11:payment_settlement",
+		},
+		{
+			name:    "falls back to the status",
+			request: &subOrderDTO.PaymentRequest{PaymentID: paymentID, Status: "settlement"},
+			want:    "payment:0b8f4a52-2f4e-4b53-9d8a-3f0c1c6a9e11:settlement",
+		},
+		{
+			name: "ignores the message id",
+			request: &subOrderDTO.PaymentRequest{
+				MessageID: &messageID,
+				PaymentID: paymentID,
+				Event:     "payment_settlement",
+			},
+			want: "payment:0b8f4a52-2f4e-4b53-9d8a-3f0c1c6a9e11:payment_settlement",
+		},
+	}
+
+	service := &SubOrder{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.paymentEventKey(tt.request)
+			if got != tt.want {
+				t.Errorf("paymentEventKey() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"order-service/common/money"
+	"order-service/common/sentry"
+	"order-service/constant"
+	errOrder "order-service/constant/error/order"
+	voucherDTO "order-service/domain/dto/voucher"
+	"order-service/domain/models"
+	"order-service/repositories"
+	"order-service/utils/helper"
+)
+
+type Voucher struct {
+	repository repositories.IRepositoryRegistry
+	sentry     sentry.ISentry
+}
+
+type IVoucherService interface {
+	CreateVoucher(context.Context, *voucherDTO.VoucherRequest) (*voucherDTO.VoucherResponse, error)
+	GetVoucherList(context.Context, *voucherDTO.VoucherRequestParam) (*helper.PaginationResult, error)
+	GetVoucherDetail(context.Context, string) (*voucherDTO.VoucherResponse, error)
+	UpdateVoucher(context.Context, string, *voucherDTO.VoucherRequest) (*voucherDTO.VoucherResponse, error)
+	DeleteVoucher(context.Context, string) error
+	Apply(context.Context, *gorm.DB, *voucherDTO.ApplyVoucherRequest) (*models.Voucher, money.Money, error)
+	Redeem(context.Context, *gorm.DB, *voucherDTO.RedeemVoucherRequest) error
+	Release(context.Context, *gorm.DB, uint) error
+}
+
+func NewVoucherService(
+	repository repositories.IRepositoryRegistry,
+	sentry sentry.ISentry,
+) IVoucherService {
+	return &Voucher{
+		repository: repository,
+		sentry:     sentry,
+	}
+}
+
+func (o *Voucher) CreateVoucher(
+	ctx context.Context,
+	request *voucherDTO.VoucherRequest,
+) (*voucherDTO.VoucherResponse, error) {
+	const logCtx = "services.voucher.voucher.CreateVoucher"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	err := o.validateVoucher(ctx, nil, request)
+	if err != nil {
+		return nil, err
+	}
+
+	voucher, err := o.repository.GetVoucher().Create(ctx, o.repository.GetTx(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toVoucherResponse(voucher), nil
+}
+
+func (o *Voucher) GetVoucherList(
+	ctx context.Context,
+	request *voucherDTO.VoucherRequestParam,
+) (*helper.PaginationResult, error) {
+	const logCtx = "services.voucher.voucher.GetVoucherList"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	vouchers, total, err := o.repository.GetVoucher().FindAllWithPagination(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]voucherDTO.VoucherResponse, 0, len(vouchers))
+	for i := range vouchers {
+		responses = append(responses, *o.toVoucherResponse(&vouchers[i]))
+	}
+
+	pagination := helper.PaginationParam{
+		Count: total,
+		Page:  request.Page,
+		Limit: request.Limit,
+		Data:  responses,
+	}
+	response := helper.GeneratePagination(pagination)
+	return &response, nil
+}
+
+func (o *Voucher) GetVoucherDetail(ctx context.Context, voucherUUID string) (*voucherDTO.VoucherResponse, error) {
+	const logCtx = "services.voucher.voucher.GetVoucherDetail"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	voucher, err := o.repository.GetVoucher().FindOneByUUID(ctx, voucherUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toVoucherResponse(voucher), nil
+}
+
+func (o *Voucher) UpdateVoucher(
+	ctx context.Context,
+	voucherUUID string,
+	request *voucherDTO.VoucherRequest,
+) (*voucherDTO.VoucherResponse, error) {
+	const logCtx = "services.voucher.voucher.UpdateVoucher"
+	var (
+		voucher *models.Voucher
+		txErr   error
+		span    = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	voucher, err := o.repository.GetVoucher().FindOneByUUID(ctx, voucherUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.validateVoucher(ctx, voucher, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		voucher, txErr = o.repository.GetVoucher().Update(ctx, tx, voucher, request)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toVoucherResponse(voucher), nil
+}
+
+func (o *Voucher) DeleteVoucher(ctx context.Context, voucherUUID string) error {
+	const logCtx = "services.voucher.voucher.DeleteVoucher"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	voucher, err := o.repository.GetVoucher().FindOneByUUID(ctx, voucherUUID)
+	if err != nil {
+		return err
+	}
+
+	return o.repository.GetVoucher().Delete(ctx, o.repository.GetTx(), voucher.ID)
+}
+
+// Apply locks the voucher row for the rest of the transaction and checks it against the order,
+// it returns the voucher along with the discount for the given amount. The usage is only
+// recorded once Redeem is called with the created order.
+func (o *Voucher) Apply(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *voucherDTO.ApplyVoucherRequest,
+) (*models.Voucher, money.Money, error) {
+	const logCtx = "services.voucher.voucher.Apply"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	voucher, err := o.repository.GetVoucher().FindOneByCodeWithLocking(ctx, tx, request.Code)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	if !voucher.IsActive || now.Before(voucher.StartDate) || now.After(voucher.EndDate) {
+		return nil, 0, errOrder.ErrVoucherNotActive
+	}
+
+	// fixed amounts and discount caps are valued in rupiah, so they cannot discount an order in
+	// another currency
+	isRupiahValued := voucher.DiscountType == constant.VoucherFixed || voucher.MaxDiscountAmount != nil
+	if !o.isPackageAllowed(voucher, request.PackageID) ||
+		(isRupiahValued && request.Currency != constant.DefaultCurrency) {
+		return nil, 0, errOrder.ErrVoucherNotApplicable
+	}
+
+	if voucher.UsageLimit != nil && voucher.UsedCount >= *voucher.UsageLimit {
+		return nil, 0, errOrder.ErrVoucherUsageLimitReached
+	}
+
+	if voucher.UsageLimitPerCustomer != nil {
+		total, err := o.repository.GetVoucher().CountRedemptionByCustomerID(ctx, tx, voucher.ID, request.CustomerID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if total >= int64(*voucher.UsageLimitPerCustomer) {
+			return nil, 0, errOrder.ErrVoucherCustomerLimitReached
+		}
+	}
+
+	discount := o.calculateDiscount(voucher, request.Amount, request.Currency)
+	if discount <= 0 {
+		return nil, 0, errOrder.ErrVoucherNotApplicable
+	}
+
+	return voucher, discount, nil
+}
+
+func (o *Voucher) Redeem(ctx context.Context, tx *gorm.DB, request *voucherDTO.RedeemVoucherRequest) error {
+	const logCtx = "services.voucher.voucher.Redeem"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.repository.GetVoucher().Redeem(ctx, tx, request)
+}
+
+// Release gives the usage of a released order back to its voucher so the customer is able to use
+// it again on the next order.
+func (o *Voucher) Release(ctx context.Context, tx *gorm.DB, orderID uint) error {
+	const logCtx = "services.voucher.voucher.Release"
+	var (
+		span = o.sentry.StartSpan(ctx, logCtx)
+	)
+	ctx = o.sentry.SpanContext(span)
+	defer o.sentry.Finish(span)
+
+	return o.repository.GetVoucher().Release(ctx, tx, orderID)
+}
+
+func (o *Voucher) validateVoucher(
+	ctx context.Context,
+	voucher *models.Voucher,
+	request *voucherDTO.VoucherRequest,
+) error {
+	if request.DiscountType == constant.VoucherPercentage && request.Value > 100 {
+		return errOrder.ErrInvalidVoucher
+	}
+
+	if voucher != nil && request.UsageLimit != nil && *request.UsageLimit < voucher.UsedCount {
+		return errOrder.ErrInvalidVoucher
+	}
+
+	existing, err := o.repository.GetVoucher().FindOneByCode(ctx, request.Code)
+	if err != nil {
+		if errors.Is(err, errOrder.ErrVoucherNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if voucher == nil || existing.ID != voucher.ID {
+		return errOrder.ErrVoucherCodeExists
+	}
+	return nil
+}
+
+func (o *Voucher) isPackageAllowed(voucher *models.Voucher, packageID string) bool {
+	if len(voucher.Packages) == 0 {
+		return true
+	}
+
+	for _, voucherPackage := range voucher.Packages {
+		if voucherPackage.PackageID == packageID {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Voucher) calculateDiscount(
+	voucher *models.Voucher,
+	amount money.Money,
+	currency constant.Currency,
+) money.Money {
+	discount := voucher.Amount
+	if voucher.DiscountType == constant.VoucherPercentage {
+		discount = amount.Percent(voucher.Value, currency.Decimals())
+		if voucher.MaxDiscountAmount != nil {
+			discount = money.Min(discount, *voucher.MaxDiscountAmount)
+		}
+	}
+	return money.Min(discount, amount)
+}
+
+func (o *Voucher) toVoucherResponse(voucher *models.Voucher) *voucherDTO.VoucherResponse {
+	packageIDs := make([]string, 0, len(voucher.Packages))
+	for _, voucherPackage := range voucher.Packages {
+		packageIDs = append(packageIDs, voucherPackage.PackageID)
+	}
+
+	return &voucherDTO.VoucherResponse{
+		UUID:                  voucher.UUID,
+		Code:                  voucher.Code,
+		Name:                  voucher.Name,
+		Description:           voucher.Description,
+		DiscountType:          voucher.DiscountType,
+		Value:                 voucher.Value,
+		Amount:                voucher.Amount,
+		MaxDiscountAmount:     voucher.MaxDiscountAmount,
+		UsageLimit:            voucher.UsageLimit,
+		UsageLimitPerCustomer: voucher.UsageLimitPerCustomer,
+		UsedCount:             voucher.UsedCount,
+		StartDate:             voucher.StartDate,
+		EndDate:               voucher.EndDate,
+		IsActive:              voucher.IsActive,
+		PackageIDs:            packageIDs,
+		CreatedAt:             voucher.CreatedAt,
+		UpdatedAt:             voucher.UpdatedAt,
+	}
+}
@@ -94,6 +94,13 @@ func ErrorValidationResponse(err error) (validationResponses []ValidationRespons
 					Field:   err.Field(),
 					Message: fmt.Sprintf("%s is a exclude if %s is empty", err.Field(), err.Param()),
 				})
+			case "excluded_if":
+				paramString := err.Param()
+				formattedParams := strings.Replace(paramString, " ", " is ", -1) //nolint:gocritic
+				validationResponses = append(validationResponses, ValidationResponse{
+					Field:   err.Field(),
+					Message: fmt.Sprintf("%s is not allowed if %s", err.Field(), formattedParams),
+				})
 			case "ltecsfield":
 				validationResponses = append(validationResponses, ValidationResponse{
 					Field:   err.Field(),
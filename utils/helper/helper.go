@@ -2,9 +2,13 @@ package helper
 
 import (
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	log "github.com/sirupsen/logrus"
@@ -14,6 +18,9 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+
+	"order-service/common/money"
+	"order-service/constant"
 )
 
 type PaginationParam struct {
@@ -33,6 +40,23 @@ type PaginationResult struct {
 	Data         interface{} `json:"data"`
 }
 
+type CursorPaginationParam struct {
+	Limit      int         `json:"limit"`
+	NextCursor *Cursor     `json:"nextCursor"`
+	Data       interface{} `json:"data"`
+}
+
+type CursorPaginationResult struct {
+	NextCursor *string     `json:"nextCursor,omitempty"`
+	Limit      int         `json:"pageSize"`
+	Data       interface{} `json:"data"`
+}
+
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+}
+
 func GeneratePagination(params PaginationParam) PaginationResult {
 	totalPage := int(math.Ceil(float64(params.Count) / float64(params.Limit)))
 
@@ -58,6 +82,44 @@ func GeneratePagination(params PaginationParam) PaginationResult {
 	return result
 }
 
+func GenerateCursorPagination(params CursorPaginationParam) CursorPaginationResult {
+	result := CursorPaginationResult{
+		Limit: params.Limit,
+		Data:  params.Data,
+	}
+
+	if params.NextCursor != nil {
+		nextCursor := EncodeCursor(*params.NextCursor)
+		result.NextCursor = &nextCursor
+	}
+
+	return result
+}
+
+func EncodeCursor(cursor Cursor) string {
+	raw, _ := json.Marshal(cursor) //nolint:errchkjson
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(raw, &cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.ID == 0 || cursor.CreatedAt.IsZero() {
+		return nil, errors.New("cursor is incomplete") //nolint:goerr113
+	}
+
+	return &cursor, nil
+}
+
 func BindFromJSON(dest any, filename, path string) error {
 	v := viper.New()
 
@@ -157,30 +219,6 @@ func GenerateSHA256(inputString string) string {
 	return hashString
 }
 
-func ConvertToIndonesianMonth(englishMonth string) string {
-	monthMap := map[string]string{
-		"January":   "Januari",
-		"February":  "Februari",
-		"March":     "Maret",
-		"April":     "April",
-		"May":       "Mei",
-		"June":      "Juni",
-		"July":      "Juli",
-		"August":    "Agustus",
-		"September": "September",
-		"October":   "Oktober",
-		"November":  "November",
-		"December":  "Desember",
-	}
-
-	indonesianMonth, found := monthMap[englishMonth]
-	if !found {
-		return errors.New("month not found").Error() //nolint:goerr113
-	}
-
-	return indonesianMonth
-}
-
 func Ucwords(s string) string {
 	firstLetter := strings.ToUpper(string(s[0]))
 	result := firstLetter + s[1:]
@@ -193,13 +231,25 @@ func Ucwords(s string) string {
 	return result
 }
 
-func RupiahFormat(amount *float64) string {
-	stringValue := "0"
-	if amount != nil {
-		humanizeValue := humanize.CommafWithDigits(*amount, 0)
-		stringValue = strings.ReplaceAll(humanizeValue, ",", ".")
+// CurrencyFormat prints the amount with the separators and decimals of the currency, rounding
+// half away from zero for currencies without decimals such as rupiah.
+func CurrencyFormat(amount *money.Money, currency constant.Currency) string {
+	if amount == nil {
+		return "0"
 	}
-	return stringValue
+
+	format := currency.Format()
+	value, sign := *amount, ""
+	if value < 0 {
+		value, sign = -value, "-"
+	}
+
+	if format.Decimals == 0 {
+		return sign + strings.ReplaceAll(humanize.Comma(value.Units()), ",", format.Thousand)
+	}
+
+	units := strings.ReplaceAll(humanize.Comma(value.Minor()/100), ",", format.Thousand)
+	return fmt.Sprintf("%s%s%s%02d", sign, units, format.Separator, value.Minor()%100)
 }
 
 func NewPointer[T any](t T) *T {
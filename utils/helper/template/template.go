@@ -1,6 +1,11 @@
 package template
 
-import "order-service/config"
+import (
+	"fmt"
+
+	"order-service/common/locale"
+	"order-service/config"
+)
 
 func GetTemplateIDByName(name string) *string {
 	templates := config.Config.InternalService.Notification.Templates
@@ -11,3 +16,14 @@ func GetTemplateIDByName(name string) *string {
 	}
 	return nil
 }
+
+// GetTemplateIDByLocale prefers the template registered for the locale, named like prepaid-en,
+// and falls back to the template written in the default language.
+func GetTemplateIDByLocale(name string, language locale.Locale) *string {
+	if language != locale.Default {
+		if templateID := GetTemplateIDByName(fmt.Sprintf("%s-%s", name, language)); templateID != nil {
+			return templateID
+		}
+	}
+	return GetTemplateIDByName(name)
+}
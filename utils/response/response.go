@@ -1,9 +1,11 @@
 package response
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"net/http"
 
+	"order-service/common/locale"
 	"order-service/common/sentry"
 	constant "order-service/constant/error"
 )
@@ -38,7 +40,7 @@ func HTTPResponse(param ParamHTTPResp) {
 		message = *param.Message
 	} else if param.Err != nil {
 		if constant.ErrorMapping(param.Err) {
-			message = param.Err.Error()
+			message = ErrorMessage(param.Gin.Request.Context(), param.Err)
 		}
 	}
 
@@ -50,3 +52,12 @@ func HTTPResponse(param ParamHTTPResp) {
 	param.Sentry.CaptureException(param.Err)
 	return //nolint:gosimple
 }
+
+// ErrorMessage translates the error message when the caller asked for a locale, the responses
+// stay in English otherwise so existing clients are not affected.
+func ErrorMessage(ctx context.Context, err error) string {
+	if language, ok := locale.Requested(ctx); ok {
+		return language.Error(err)
+	}
+	return err.Error()
+}